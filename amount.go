@@ -0,0 +1,257 @@
+package numeroaletras
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Currency describe una moneda. MinorDigits es la cantidad de cifras de la
+// unidad menor: con 0 se asumen dos, como en el sol o el dólar, y
+// SinUnidadMenor indica una moneda sin fracciones, como el yen.
+type Currency struct {
+	Code        string
+	Name        string
+	Cents       string
+	MinorDigits int
+}
+
+const SinUnidadMenor = -1
+
+var (
+	PEN = Currency{Code: "PEN", Name: "SOLES", Cents: "CÉNTIMOS", MinorDigits: 2}
+	USD = Currency{Code: "USD", Name: "DÓLARES", Cents: "CENTAVOS", MinorDigits: 2}
+	EUR = Currency{Code: "EUR", Name: "EUROS", Cents: "CÉNTIMOS", MinorDigits: 2}
+	MXN = Currency{Code: "MXN", Name: "PESOS", Cents: "CENTAVOS", MinorDigits: 2}
+)
+
+var currencies = map[string]Currency{
+	PEN.Code: PEN,
+	USD.Code: USD,
+	EUR.Code: EUR,
+	MXN.Code: MXN,
+}
+
+func CurrencyByCode(code string) (Currency, bool) {
+	c, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	return c, ok
+}
+
+func (c Currency) minorDigits() int {
+	switch {
+	case c.MinorDigits == 0:
+		return 2
+	case c.MinorDigits < 0:
+		return 0
+	}
+	return c.MinorDigits
+}
+
+var defaultConverter = NewNumeroALetras()
+
+// Amount guarda el importe como dígitos exactos, sin pasar por float64.
+type Amount struct {
+	Currency Currency
+	negative bool
+	whole    string
+	fraction string
+}
+
+func NewAmount(value float64, decimals int, currency Currency) (Amount, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Amount{}, fmt.Errorf("numeroaletras: importe inválido %v", value)
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+	return ParseAmount(strconv.FormatFloat(value, 'f', decimals, 64), currency)
+}
+
+func ParseAmount(value string, currency Currency) (Amount, error) {
+	a := Amount{Currency: currency}
+	s := strings.TrimSpace(value)
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		a.negative = s[0] == '-'
+		s = s[1:]
+	}
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" || !isDigits(whole) || (fraction != "" && !isDigits(fraction)) || strings.HasSuffix(s, ".") {
+		return Amount{}, fmt.Errorf("numeroaletras: importe inválido %q", value)
+	}
+	a.whole = strings.TrimLeft(whole, "0")
+	a.fraction = fraction
+	if a.isZero() {
+		a.negative = false
+	}
+	return a, nil
+}
+
+func (a Amount) Decimals() int {
+	return len(a.fraction)
+}
+
+func (a Amount) IsNegative() bool {
+	return a.negative
+}
+
+func (a Amount) Float64() float64 {
+	f, _ := strconv.ParseFloat(a.String(), 64)
+	return f
+}
+
+func (a Amount) String() string {
+	var b strings.Builder
+	if a.negative {
+		b.WriteByte('-')
+	}
+	b.WriteString(a.wholeDigits())
+	if a.fraction != "" {
+		b.WriteByte('.')
+		b.WriteString(a.fraction)
+	}
+	return b.String()
+}
+
+func (a Amount) Words() (string, error) {
+	return a.WordsWith(defaultConverter)
+}
+
+func (a Amount) WordsWith(n *NumeroALetras) (string, error) {
+	cents, err := a.centimos()
+	if err != nil {
+		return "", err
+	}
+	return n.money(a.signedWhole(), cents, a.Currency.Name, a.Currency.Cents)
+}
+
+func (a Amount) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), a.String())
+	case 'L':
+		words, err := a.Words()
+		if err != nil {
+			fmt.Fprintf(f, "%%!L(%v)", err)
+			return
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), words)
+	default:
+		fmt.Fprintf(f, "%%!%c(numeroaletras.Amount=%s)", verb, a.String())
+	}
+}
+
+type amountJSON struct {
+	Value    json.Number `json:"value"`
+	Currency string      `json:"currency,omitempty"`
+	Words    string      `json:"words,omitempty"`
+}
+
+// MarshalJSON omite words si el importe no se puede deletrear, por ejemplo
+// 1.125 en soles leído de una columna NUMERIC(12,4).
+func (a Amount) MarshalJSON() ([]byte, error) {
+	words, _ := a.Words()
+	return json.Marshal(amountJSON{
+		Value:    json.Number(a.String()),
+		Currency: a.Currency.Code,
+		Words:    words,
+	})
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Value    json.RawMessage `json:"value"`
+		Currency string          `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	value := strings.Trim(string(raw.Value), `"`)
+	currency := a.Currency
+	if raw.Currency != "" {
+		c, ok := CurrencyByCode(raw.Currency)
+		if !ok {
+			c = Currency{Code: strings.ToUpper(raw.Currency)}
+		}
+		currency = c
+	}
+	parsed, err := ParseAmount(value, currency)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+func (a *Amount) Scan(src any) error {
+	var value string
+	switch v := src.(type) {
+	case nil:
+		return fmt.Errorf("numeroaletras: NULL no es un Amount; use sql.Null[Amount]")
+	case []byte:
+		value = string(v)
+	case string:
+		value = v
+	case int64:
+		value = strconv.FormatInt(v, 10)
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("numeroaletras: no se puede leer %T como Amount", src)
+	}
+	parsed, err := ParseAmount(value, a.Currency)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func (a Amount) wholeDigits() string {
+	if a.whole == "" {
+		return "0"
+	}
+	return a.whole
+}
+
+func (a Amount) signedWhole() string {
+	if a.negative {
+		return "-" + a.wholeDigits()
+	}
+	return a.wholeDigits()
+}
+
+// centimos lleva la fracción a las cifras de la unidad menor de la moneda:
+// 1234.5 son cincuenta céntimos. Los ceros sobrantes se descartan, pero
+// 12.125 no cabe en céntimos y devuelve error.
+func (a Amount) centimos() (string, error) {
+	digits := a.Currency.minorDigits()
+	switch {
+	case a.fraction == "":
+		return "", nil
+	case len(a.fraction) < digits:
+		return a.fraction + strings.Repeat("0", digits-len(a.fraction)), nil
+	case !isZero(a.fraction[digits:]):
+		return "", fmt.Errorf("numeroaletras: el importe %s tiene más decimales que la moneda", a.String())
+	}
+	return a.fraction[:digits], nil
+}
+
+func (a Amount) isZero() bool {
+	return isZero(a.whole) && isZero(a.fraction)
+}
+
+func isDigits[T string | []byte](s T) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
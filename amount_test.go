@@ -0,0 +1,220 @@
+package numeroaletras
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestAmountFormat(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		format   string
+		expected string
+	}{
+		"Dígitos con %v": {
+			value:    "1234.50",
+			format:   "%v",
+			expected: "1234.50",
+		},
+		"Dígitos con ancho": {
+			value:    "12.5",
+			format:   "%8s",
+			expected: "    12.5",
+		},
+		"Letras con %L": {
+			value:    "1234.50",
+			format:   "%L",
+			expected: "MIL DOSCIENTOS TREINTA Y CUATRO SOLES CON CINCUENTA CÉNTIMOS",
+		},
+		"Negativo con %L": {
+			value:    "-20.00",
+			format:   "%L",
+			expected: "MENOS VEINTE SOLES",
+		},
+		"Verbo no soportado": {
+			value:    "1.00",
+			format:   "%d",
+			expected: "%!d(numeroaletras.Amount=1.00)",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			a, err := ParseAmount(tt.value, PEN)
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) returned error: %v", tt.value, err)
+			}
+			if got := fmt.Sprintf(tt.format, a); got != tt.expected {
+				t.Errorf("Sprintf(%q, %v) = %q; want %q", tt.format, tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected string
+		wantErr  bool
+	}{
+		"Entero":             {value: "100", expected: "100"},
+		"Ceros a la derecha": {value: "100.500", expected: "100.500"},
+		"Ceros a la izq":     {value: "000.10", expected: "0.10"},
+		"Signo positivo":     {value: "+7.5", expected: "7.5"},
+		"Cero negativo":      {value: "-0.00", expected: "0.00"},
+		"Vacío":              {value: "", wantErr: true},
+		"Punto final":        {value: "12.", wantErr: true},
+		"Letras":             {value: "12a", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			a, err := ParseAmount(tt.value, PEN)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseAmount(%q) = %v; want error", tt.value, a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) returned error: %v", tt.value, err)
+			}
+			if a.String() != tt.expected {
+				t.Errorf("ParseAmount(%q) = %v; want %v", tt.value, a, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewAmount(t *testing.T) {
+	a, err := NewAmount(1700.5, 2, USD)
+	if err != nil {
+		t.Fatalf("NewAmount returned error: %v", err)
+	}
+	if a.String() != "1700.50" || a.Decimals() != 2 {
+		t.Errorf("NewAmount(1700.5, 2) = %v (%d decimales); want 1700.50", a, a.Decimals())
+	}
+	words, err := a.Words()
+	if err != nil {
+		t.Fatalf("Words returned error: %v", err)
+	}
+	if words != "MIL SETECIENTOS DÓLARES CON CINCUENTA CENTAVOS" {
+		t.Errorf("Words() = %v", words)
+	}
+}
+
+func TestAmountWords(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		currency Currency
+		expected string
+	}{
+		"Un decimal":          {value: "1234.5", currency: PEN, expected: "MIL DOSCIENTOS TREINTA Y CUATRO SOLES CON CINCUENTA CÉNTIMOS"},
+		"Dos decimales":       {value: "1234.05", currency: PEN, expected: "MIL DOSCIENTOS TREINTA Y CUATRO SOLES CON CINCO CÉNTIMOS"},
+		"Ceros a la derecha":  {value: "100.500", currency: USD, expected: "CIEN DÓLARES CON CINCUENTA CENTAVOS"},
+		"Sin decimales":       {value: "7", currency: EUR, expected: "SIETE EUROS"},
+		"Tres cifras menores": {value: "2.5", currency: Currency{Code: "KWD", Name: "DINARES", Cents: "FILS", MinorDigits: 3}, expected: "DOS DINARES CON QUINIENTOS FILS"},
+		"Sin unidad menor":    {value: "500.00", currency: Currency{Code: "JPY", Name: "YENES", MinorDigits: SinUnidadMenor}, expected: "QUINIENTOS YENES"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			a, err := ParseAmount(tt.value, tt.currency)
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) returned error: %v", tt.value, err)
+			}
+			if words := fmt.Sprintf("%L", a); words != tt.expected {
+				t.Errorf("%%L de %q = %v; want %v", tt.value, words, tt.expected)
+			}
+		})
+	}
+
+	a, _ := ParseAmount("12.125", USD)
+	if _, err := a.Words(); err == nil {
+		t.Error("Words() de 12.125 expected error, got nil")
+	}
+	a, _ = ParseAmount("500.5", Currency{Code: "JPY", Name: "YENES", MinorDigits: SinUnidadMenor})
+	if _, err := a.Words(); err == nil {
+		t.Error("Words() de 500.5 yenes expected error, got nil")
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	a, _ := ParseAmount("1100.50", PEN)
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	expected := `{"value":1100.50,"currency":"PEN","words":"MIL CIEN SOLES CON CINCUENTA CÉNTIMOS"}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal = %s; want %s", data, expected)
+	}
+
+	var back Amount
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if back.String() != a.String() || back.Currency != PEN {
+		t.Errorf("json.Unmarshal = %v %v; want %v %v", back, back.Currency, a, PEN)
+	}
+
+	if err := json.Unmarshal([]byte(`{"value":"3.25","currency":"usd"}`), &back); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if back.String() != "3.25" || back.Currency != USD {
+		t.Errorf("json.Unmarshal = %v %v; want 3.25 %v", back, back.Currency, USD)
+	}
+
+	// Un importe que no cabe en céntimos se serializa sin words.
+	a, _ = ParseAmount("1.125", PEN)
+	data, err = json.Marshal(a)
+	if expected := `{"value":1.125,"currency":"PEN"}`; err != nil || string(data) != expected {
+		t.Errorf("json.Marshal(1.125) = %s, %v; want %s", data, err, expected)
+	}
+}
+
+func TestAmountSQL(t *testing.T) {
+	tests := map[string]struct {
+		src      any
+		expected string
+		words    string
+	}{
+		"Bytes de NUMERIC": {src: []byte("1234.56"), expected: "1234.56", words: "MIL DOSCIENTOS TREINTA Y CUATRO SOLES CON CINCUENTA Y SEIS CÉNTIMOS"},
+		"Texto":            {src: "0.10", expected: "0.10", words: "CERO SOLES CON DIEZ CÉNTIMOS"},
+		"Entero":           {src: int64(42), expected: "42", words: "CUARENTA Y DOS SOLES"},
+		"Float":            {src: 2.5, expected: "2.5", words: "DOS SOLES CON CINCUENTA CÉNTIMOS"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			a := Amount{Currency: PEN}
+			if err := a.Scan(tt.src); err != nil {
+				t.Fatalf("Scan(%v) returned error: %v", tt.src, err)
+			}
+			if a.String() != tt.expected || a.Currency != PEN {
+				t.Errorf("Scan(%v) = %v %v; want %v", tt.src, a, a.Currency, tt.expected)
+			}
+			if words, err := a.Words(); err != nil || words != tt.words {
+				t.Errorf("Words() = %v, %v; want %v", words, err, tt.words)
+			}
+			v, err := a.Value()
+			if err != nil || v != tt.expected {
+				t.Errorf("Value() = %v, %v; want %v", v, err, tt.expected)
+			}
+		})
+	}
+
+	var a Amount
+	if err := a.Scan(true); err == nil {
+		t.Error("Scan(true) expected error, got nil")
+	}
+	a, _ = ParseAmount("5", PEN)
+	if err := a.Scan(nil); err == nil || a.String() != "5" {
+		t.Errorf("Scan(nil) = %v, %v; want error y el importe sin cambios", a, err)
+	}
+}
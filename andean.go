@@ -0,0 +1,214 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Andean deletrea en quechua (sureño, Cusco-Collao) y aimara. Ambas lenguas
+// son aglutinantes: la unidad que sigue a una decena, centena o millar toma
+// un sufijo (CHUNKA HUKNIYUQ, TUNKA MAYANI) y el "CON" del castellano es el
+// sufijo comitativo Conector (-WAN, -MPI) pegado a la última palabra.
+type Andean struct {
+	Conector string
+	tablas   andeanTables
+}
+
+type andeanTables struct {
+	unidades        []string
+	multiplicadores []string
+	cero            string
+	diez            string
+	cien            string
+	mil             string
+	millon          string
+	conector        string
+	sufijo          func(string) string
+	monedas         map[string]Currency
+}
+
+var quechuaTables = andeanTables{
+	unidades:        []string{"", "HUK", "ISKAY", "KIMSA", "TAWA", "PICHQA", "SUQTA", "QANCHIS", "PUSAQ", "ISQUN"},
+	multiplicadores: []string{"", "HUK", "ISKAY", "KIMSA", "TAWA", "PICHQA", "SUQTA", "QANCHIS", "PUSAQ", "ISQUN"},
+	cero:            "CH'USAQ",
+	diez:            "CHUNKA",
+	cien:            "PACHAK",
+	mil:             "WARANQA",
+	millon:          "HUNU",
+	conector:        "WAN",
+	sufijo: func(word string) string {
+		if endsInVowel(word) {
+			return word + "YUQ"
+		}
+		return word + "NIYUQ"
+	},
+	monedas: map[string]Currency{
+		"PEN": {Code: "PEN", Name: "SOL", Cents: "SENTIMU"},
+		"USD": {Code: "USD", Name: "DULAR", Cents: "SENTAWU"},
+		"EUR": {Code: "EUR", Name: "EURU", Cents: "SENTIMU"},
+	},
+}
+
+var aymaraTables = andeanTables{
+	unidades:        []string{"", "MAYA", "PAYA", "KIMSA", "PUSI", "PHISQA", "SUXTA", "PAQALLQU", "KIMSAQALLQU", "LLÄTUNKA"},
+	multiplicadores: []string{"", "MAYA", "PÄ", "KIMSA", "PUSI", "PHISQA", "SUXTA", "PAQALLQU", "KIMSAQALLQU", "LLÄTUNKA"},
+	cero:            "CH'USA",
+	diez:            "TUNKA",
+	cien:            "PATAKA",
+	mil:             "WARANQA",
+	millon:          "JUNU",
+	conector:        "MPI",
+	sufijo: func(word string) string {
+		if endsInVowel(word) {
+			return word + "NI"
+		}
+		return word + "ANI"
+	},
+	monedas: map[string]Currency{
+		"PEN": {Code: "PEN", Name: "SOL", Cents: "SINTIMU"},
+		"BOB": {Code: "BOB", Name: "PISU", Cents: "SINTAWU"},
+		"USD": {Code: "USD", Name: "DULAR", Cents: "SINTAWU"},
+	},
+}
+
+func NewQuechua() *Andean {
+	return &Andean{Conector: quechuaTables.conector, tablas: quechuaTables}
+}
+
+func NewAymara() *Andean {
+	return &Andean{Conector: aymaraTables.conector, tablas: aymaraTables}
+}
+
+func init() {
+	for _, tag := range []string{"qu", "qu-PE", "quz", "quz-PE"} {
+		Register(tag, func() Speller { return NewQuechua() })
+	}
+	for _, tag := range []string{"ay", "ay-PE", "ay-BO"} {
+		Register(tag, func() Speller { return NewAymara() })
+	}
+}
+
+// Currency devuelve la moneda con sus nombres en la lengua del Speller.
+func (a *Andean) Currency(code string) (Currency, bool) {
+	c, ok := a.tablas.monedas[strings.ToUpper(strings.TrimSpace(code))]
+	return c, ok
+}
+
+func (a *Andean) ToWords(number float64, decimals int) (string, error) {
+	factor := math.Pow(10, float64(decimals))
+	number = math.Round(number*factor) / factor
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := a.wholeNumber(whole)
+	if err != nil {
+		return "", err
+	}
+	if fraction == "" || isZero(fraction) {
+		return wholeWords, nil
+	}
+	decimal, err := a.wholeNumber(fraction)
+	if err != nil {
+		return "", err
+	}
+	return wholeWords + " " + decimal + strings.ToUpper(a.Conector), nil
+}
+
+func (a *Andean) ToMoney(number float64, decimals int, currency, cents string) (string, error) {
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := a.wholeNumber(whole)
+	if err != nil {
+		return "", err
+	}
+	wholeWords += " " + strings.ToUpper(currency)
+	if fraction == "" || isZero(fraction) {
+		return wholeWords, nil
+	}
+	decimal, err := a.wholeNumber(fraction)
+	if err != nil {
+		return "", err
+	}
+	return wholeWords + " " + decimal + " " + strings.ToUpper(cents) + strings.ToUpper(a.Conector), nil
+}
+
+func (a *Andean) ToInvoice(number float64, decimals int, currency string) (string, error) {
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := a.wholeNumber(whole)
+	if err != nil {
+		return "", err
+	}
+	decimal := "00/100"
+	if fraction != "" {
+		decimal = fmt.Sprintf("%02d/100", mustAtoi(fraction))
+	}
+	return fmt.Sprintf("%s %s %s", wholeWords, decimal, strings.ToUpper(currency)), nil
+}
+
+func (a *Andean) wholeNumber(number string) (string, error) {
+	num, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	if num >= 1e12 {
+		return "", fmt.Errorf("numeroaletras: número fuera de rango %s", number)
+	}
+	if num == 0 {
+		return a.tablas.cero, nil
+	}
+	return a.convertNumber(num), nil
+}
+
+func (a *Andean) convertNumber(num uint64) string {
+	t := a.tablas
+
+	var parts []string
+	if m := num / 1e6; m > 0 {
+		parts = append(parts, a.multiply(m, t.millon, false))
+	}
+	if th := num / 1000 % 1000; th > 0 {
+		parts = append(parts, a.multiply(th, t.mil, true))
+	}
+	if h := num / 100 % 10; h > 0 {
+		parts = append(parts, a.multiply(h, t.cien, true))
+	}
+	if d := num / 10 % 10; d > 0 {
+		parts = append(parts, a.multiply(d, t.diez, true))
+	}
+	if u := num % 10; u > 0 {
+		if len(parts) > 0 {
+			parts = append(parts, t.sufijo(t.unidades[u]))
+		} else {
+			parts = append(parts, t.unidades[u])
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// multiply antepone el multiplicador a la potencia; con implicitOne el uno
+// se omite (PACHAK y no HUK PACHAK).
+func (a *Andean) multiply(n uint64, word string, implicitOne bool) string {
+	switch {
+	case n == 1 && implicitOne:
+		return word
+	case n < 10:
+		return a.tablas.multiplicadores[n] + " " + word
+	default:
+		return a.convertNumber(n) + " " + word
+	}
+}
+
+func endsInVowel(word string) bool {
+	return strings.ContainsAny(word[len(word)-1:], "AEIOU")
+}
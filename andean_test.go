@@ -0,0 +1,129 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestQuechuaToWords(t *testing.T) {
+	tests := map[string]struct {
+		number   float64
+		expected string
+	}{
+		"Cero":           {number: 0, expected: "CH'USAQ"},
+		"Pichqa":         {number: 5, expected: "PICHQA"},
+		"Chunka":         {number: 10, expected: "CHUNKA"},
+		"Once":           {number: 11, expected: "CHUNKA HUKNIYUQ"},
+		"Quince":         {number: 15, expected: "CHUNKA PICHQAYUQ"},
+		"Veintiocho":     {number: 28, expected: "ISKAY CHUNKA PUSAQNIYUQ"},
+		"Cien":           {number: 100, expected: "PACHAK"},
+		"Doscientos":     {number: 205, expected: "ISKAY PACHAK PICHQAYUQ"},
+		"Mil":            {number: 1000, expected: "WARANQA"},
+		"Mil doscientos": {number: 1234, expected: "WARANQA ISKAY PACHAK KIMSA CHUNKA TAWAYUQ"},
+		"Once mil":       {number: 11000, expected: "CHUNKA HUKNIYUQ WARANQA"},
+		"Un millón":      {number: 1000000, expected: "HUK HUNU"},
+		"Millones":       {number: 3000007, expected: "KIMSA HUNU QANCHISNIYUQ"},
+	}
+
+	q := NewQuechua()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			words, err := q.ToWords(tt.number, 0)
+			if err != nil {
+				t.Fatalf("ToWords(%v) returned error: %v", tt.number, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToWords(%v) = %v; want %v", tt.number, words, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAymaraToWords(t *testing.T) {
+	tests := map[string]struct {
+		number   float64
+		expected string
+	}{
+		"Cero":       {number: 0, expected: "CH'USA"},
+		"Paya":       {number: 2, expected: "PAYA"},
+		"Once":       {number: 11, expected: "TUNKA MAYANI"},
+		"Veinte":     {number: 20, expected: "PÄ TUNKA"},
+		"Veintidós":  {number: 22, expected: "PÄ TUNKA PAYANI"},
+		"Doscientos": {number: 200, expected: "PÄ PATAKA"},
+		"Mil nueve":  {number: 1009, expected: "WARANQA LLÄTUNKANI"},
+		"Dos mil":    {number: 2000, expected: "PÄ WARANQA"},
+	}
+
+	a := NewAymara()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			words, err := a.ToWords(tt.number, 0)
+			if err != nil {
+				t.Fatalf("ToWords(%v) returned error: %v", tt.number, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToWords(%v) = %v; want %v", tt.number, words, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAndeanToMoney(t *testing.T) {
+	tests := map[string]struct {
+		speller  *Andean
+		number   float64
+		expected string
+	}{
+		"Quechua soles":      {speller: NewQuechua(), number: 1250.50, expected: "WARANQA ISKAY PACHAK PICHQA CHUNKA SOL PICHQA CHUNKA SENTIMUWAN"},
+		"Quechua sin cénts":  {speller: NewQuechua(), number: 7, expected: "QANCHIS SOL"},
+		"Aymara soles":       {speller: NewAymara(), number: 35.20, expected: "KIMSA TUNKA PHISQANI SOL PÄ TUNKA SINTIMUMPI"},
+		"Aymara sin céntimo": {speller: NewAymara(), number: 100, expected: "PATAKA SOL"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			pen, ok := tt.speller.Currency("pen")
+			if !ok {
+				t.Fatal("Currency(pen) no encontrada")
+			}
+			money, err := tt.speller.ToMoney(tt.number, 2, pen.Name, pen.Cents)
+			if err != nil {
+				t.Fatalf("ToMoney(%v) returned error: %v", tt.number, err)
+			}
+			if money != tt.expected {
+				t.Errorf("ToMoney(%v) = %v; want %v", tt.number, money, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAndeanLocales(t *testing.T) {
+	for tag, expected := range map[string]string{"quz-PE": "KIMSA 05/100 SOL", "ay-BO": "KIMSA 05/100 SOL"} {
+		s, err := NewSpeller(tag)
+		if err != nil {
+			t.Fatalf("NewSpeller(%q) returned error: %v", tag, err)
+		}
+		invoice, _ := s.ToInvoice(3.05, 2, "sol")
+		if invoice != expected {
+			t.Errorf("NewSpeller(%q).ToInvoice(3.05) = %v; want %v", tag, invoice, expected)
+		}
+	}
+	if _, err := NewQuechua().ToWords(1e12, 0); err == nil {
+		t.Error("ToWords(1e12) expected error, got nil")
+	}
+	q := NewQuechua()
+	if _, err := q.ToWords(-5, 0); err == nil || err.Error() != "numeroaletras: número negativo -5" {
+		t.Errorf("ToWords(-5) = %v; want numeroaletras: número negativo -5", err)
+	}
+	if words, err := q.ToWords(-0.2, 0); err != nil || words != "CH'USAQ" {
+		t.Errorf("ToWords(-0.2, 0) = %v, %v; want CH'USAQ", words, err)
+	}
+	if money, err := q.ToMoney(-0.5, 2, "sol", "sentimu"); err == nil || err.Error() != "numeroaletras: número negativo -0.50" {
+		t.Errorf("ToMoney(-0.5) = %v, %v; want numeroaletras: número negativo -0.50", money, err)
+	}
+	if invoice, err := q.ToInvoice(-0.5, 2, "sol"); err == nil || err.Error() != "numeroaletras: número negativo -0.50" {
+		t.Errorf("ToInvoice(-0.5) = %v, %v; want numeroaletras: número negativo -0.50", invoice, err)
+	}
+}
@@ -0,0 +1,77 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+type Unidad struct {
+	Singular string
+	Plural   string
+	Genero   Genero
+}
+
+// Magnitud describe una cantidad en dos unidades donde Razon unidades menores
+// forman una mayor (12 meses, 60 minutos, 100 centímetros, 16 onzas).
+type Magnitud struct {
+	Mayor    Unidad
+	Menor    Unidad
+	Razon    int
+	Conector string
+}
+
+var (
+	AniosMeses        = Magnitud{Mayor: Unidad{"AÑO", "AÑOS", Masculino}, Menor: Unidad{"MES", "MESES", Masculino}, Razon: 12}
+	HorasMinutos      = Magnitud{Mayor: Unidad{"HORA", "HORAS", Femenino}, Menor: Unidad{"MINUTO", "MINUTOS", Masculino}, Razon: 60}
+	MetrosCentimetros = Magnitud{Mayor: Unidad{"METRO", "METROS", Masculino}, Menor: Unidad{"CENTÍMETRO", "CENTÍMETROS", Masculino}, Razon: 100}
+	LibrasOnzas       = Magnitud{Mayor: Unidad{"LIBRA", "LIBRAS", Femenino}, Menor: Unidad{"ONZA", "ONZAS", Femenino}, Razon: 16}
+)
+
+// ToQuantity reparte value (expresado en la unidad mayor) según la razón de
+// la magnitud: 5.5 años son CINCO AÑOS Y SEIS MESES.
+func (n *NumeroALetras) ToQuantity(value float64, m Magnitud) (string, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+		return "", fmt.Errorf("numeroaletras: cantidad inválida %v", value)
+	}
+	if m.Razon < 1 {
+		return "", fmt.Errorf("numeroaletras: razón inválida %d", m.Razon)
+	}
+	total := math.Round(value * float64(m.Razon))
+	if total > math.MaxInt64/2 {
+		return "", fmt.Errorf("numeroaletras: cantidad fuera de rango %v", value)
+	}
+	menores := int(total)
+	return n.ToQuantityParts(menores/m.Razon, menores%m.Razon, m)
+}
+
+func (n *NumeroALetras) ToQuantityParts(mayor, menor int, m Magnitud) (string, error) {
+	if mayor < 0 || menor < 0 {
+		return "", fmt.Errorf("numeroaletras: cantidad negativa %d, %d", mayor, menor)
+	}
+	if m.Razon < 1 {
+		return "", fmt.Errorf("numeroaletras: razón inválida %d", m.Razon)
+	}
+	mayor += menor / m.Razon
+	menor %= m.Razon
+
+	var parts []string
+	if mayor > 0 {
+		parts = append(parts, n.conUnidad(mayor, m.Mayor))
+	}
+	if menor > 0 {
+		parts = append(parts, n.conUnidad(menor, m.Menor))
+	}
+	if len(parts) == 0 {
+		return "CERO " + strings.ToUpper(m.Mayor.Plural), nil
+	}
+	conector := m.Conector
+	if conector == "" {
+		conector = "Y"
+	}
+	return strings.Join(parts, " "+strings.ToUpper(conector)+" "), nil
+}
+
+func (n *NumeroALetras) conUnidad(count int, u Unidad) string {
+	return conSustantivo(n.cardinal(count, u.Genero), count, strings.ToUpper(u.Singular), strings.ToUpper(u.Plural))
+}
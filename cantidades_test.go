@@ -0,0 +1,83 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestToQuantity(t *testing.T) {
+	tests := map[string]struct {
+		value    float64
+		magnitud Magnitud
+		expected string
+	}{
+		"Años y meses":      {value: 5.5, magnitud: AniosMeses, expected: "CINCO AÑOS Y SEIS MESES"},
+		"No es decimal":     {value: 5.2, magnitud: AniosMeses, expected: "CINCO AÑOS Y DOS MESES"},
+		"Un año un mes":     {value: 13.0 / 12, magnitud: AniosMeses, expected: "UN AÑO Y UN MES"},
+		"Solo meses":        {value: 0.25, magnitud: AniosMeses, expected: "TRES MESES"},
+		"Horas y minutos":   {value: 1.75, magnitud: HorasMinutos, expected: "UNA HORA Y CUARENTA Y CINCO MINUTOS"},
+		"Metros":            {value: 2.05, magnitud: MetrosCentimetros, expected: "DOS METROS Y CINCO CENTÍMETROS"},
+		"Libras femeninas":  {value: 201.125, magnitud: LibrasOnzas, expected: "DOSCIENTAS UNA LIBRAS Y DOS ONZAS"},
+		"Redondeo al mayor": {value: 2.999, magnitud: AniosMeses, expected: "TRES AÑOS"},
+		"Cero":              {value: 0, magnitud: AniosMeses, expected: "CERO AÑOS"},
+	}
+
+	n := NewNumeroALetras()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := n.ToQuantity(tt.value, tt.magnitud)
+			if err != nil {
+				t.Fatalf("ToQuantity(%v) retornó error: %v", tt.value, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToQuantity(%v) = %v; se esperaba %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToQuantityParts(t *testing.T) {
+	tests := map[string]struct {
+		mayor    int
+		menor    int
+		magnitud Magnitud
+		expected string
+	}{
+		"Partes separadas": {mayor: 5, menor: 6, magnitud: AniosMeses, expected: "CINCO AÑOS Y SEIS MESES"},
+		"Acarreo":          {mayor: 1, menor: 14, magnitud: AniosMeses, expected: "DOS AÑOS Y DOS MESES"},
+		"Solo mayor":       {mayor: 21, menor: 0, magnitud: AniosMeses, expected: "VEINTIÚN AÑOS"},
+		"Conector propio": {
+			mayor:    3,
+			menor:    1,
+			magnitud: Magnitud{Mayor: Unidad{"pie", "pies", Masculino}, Menor: Unidad{"pulgada", "pulgadas", Femenino}, Razon: 12, Conector: "con"},
+			expected: "TRES PIES CON UNA PULGADA",
+		},
+	}
+
+	n := NewNumeroALetras()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := n.ToQuantityParts(tt.mayor, tt.menor, tt.magnitud)
+			if err != nil {
+				t.Fatalf("ToQuantityParts(%v, %v) retornó error: %v", tt.mayor, tt.menor, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToQuantityParts(%v, %v) = %v; se esperaba %v", tt.mayor, tt.menor, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToQuantity_Errores(t *testing.T) {
+	n := NewNumeroALetras()
+	if _, err := n.ToQuantity(-1, AniosMeses); err == nil {
+		t.Error("ToQuantity(-1) expected error, got nil")
+	}
+	if _, err := n.ToQuantity(1, Magnitud{}); err == nil {
+		t.Error("ToQuantity sin razón expected error, got nil")
+	}
+	if _, err := n.ToQuantityParts(1, -2, AniosMeses); err == nil {
+		t.Error("ToQuantityParts(1, -2) expected error, got nil")
+	}
+}
@@ -0,0 +1,90 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type Alineacion int
+
+const (
+	AlineacionIzquierda Alineacion = iota
+	AlineacionDerecha
+	AlineacionCentro
+)
+
+// Cheque reparte el importe de ToInvoice en las dos líneas del cheque,
+// cortando entre palabras y rellenando cada línea hasta su ancho. La segunda
+// línea nunca queda vacía para que no pueda completarse a mano.
+type Cheque struct {
+	Speller    Speller
+	Anchos     [2]int
+	Relleno    rune
+	Prefijo    string
+	Sufijo     string
+	Alineacion Alineacion
+}
+
+func NewCheque(s Speller) *Cheque {
+	return &Cheque{
+		Speller:    s,
+		Anchos:     [2]int{60, 60},
+		Relleno:    '*',
+		Alineacion: AlineacionCentro,
+	}
+}
+
+func (c *Cheque) Lines(number float64, decimals int, currency string) ([]string, error) {
+	invoice, err := c.Speller.ToInvoice(number, decimals, currency)
+	if err != nil {
+		return nil, err
+	}
+	words := strings.Fields(strings.Join([]string{c.Prefijo, invoice, c.Sufijo}, " "))
+
+	var first []string
+	used := 0
+	for len(first) < len(words)-1 {
+		w := utf8.RuneCountInString(words[len(first)])
+		if len(first) > 0 {
+			w++
+		}
+		if used+w > c.Anchos[0] {
+			break
+		}
+		used += w
+		first = append(first, words[len(first)])
+	}
+	if len(first) == 0 {
+		return nil, fmt.Errorf("numeroaletras: %q no cabe en %d caracteres", words[0], c.Anchos[0])
+	}
+	second := strings.Join(words[len(first):], " ")
+	if utf8.RuneCountInString(second) > c.Anchos[1] {
+		return nil, fmt.Errorf("numeroaletras: el importe en letras excede el ancho del cheque")
+	}
+
+	return []string{
+		c.pad(strings.Join(first, " "), c.Anchos[0]),
+		c.pad(second, c.Anchos[1]),
+	}, nil
+}
+
+func (c *Cheque) pad(line string, width int) string {
+	fill := width - utf8.RuneCountInString(line)
+	if fill <= 0 {
+		return line
+	}
+	relleno := "*"
+	if c.Relleno != 0 {
+		relleno = string(c.Relleno)
+	}
+	switch c.Alineacion {
+	case AlineacionDerecha:
+		return strings.Repeat(relleno, fill) + line
+	case AlineacionCentro:
+		left := fill / 2
+		return strings.Repeat(relleno, left) + line + strings.Repeat(relleno, fill-left)
+	default:
+		return line + strings.Repeat(relleno, fill)
+	}
+}
@@ -0,0 +1,71 @@
+package numeroaletras
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestChequeLines(t *testing.T) {
+	tests := map[string]struct {
+		cheque   Cheque
+		number   float64
+		expected []string
+	}{
+		"Centrado con asteriscos": {
+			cheque:   Cheque{Anchos: [2]int{40, 20}, Relleno: '*', Alineacion: AlineacionCentro},
+			number:   1200.50,
+			expected: []string{"*******MIL DOSCIENTOS CON 50/100********", "*******SOLES********"},
+		},
+		"Corte entre palabras": {
+			cheque:   Cheque{Anchos: [2]int{20, 45}, Relleno: '-', Prefijo: "SON:", Sufijo: "EXACTOS"},
+			number:   1234.50,
+			expected: []string{"SON: MIL DOSCIENTOS-", "TREINTA Y CUATRO CON 50/100 SOLES EXACTOS----"},
+		},
+		"Alineado a la derecha": {
+			cheque:   Cheque{Anchos: [2]int{12, 12}, Relleno: '*', Alineacion: AlineacionDerecha},
+			number:   5,
+			expected: []string{"***CINCO CON", "00/100 SOLES"},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			tt.cheque.Speller = NewNumeroALetras()
+			lines, err := tt.cheque.Lines(tt.number, 2, "soles")
+			if err != nil {
+				t.Fatalf("Lines(%v) returned error: %v", tt.number, err)
+			}
+			if len(lines) != 2 || lines[0] != tt.expected[0] || lines[1] != tt.expected[1] {
+				t.Errorf("Lines(%v) = %q; want %q", tt.number, lines, tt.expected)
+			}
+		})
+	}
+}
+
+func TestChequeSegundaLineaNoVacia(t *testing.T) {
+	c := NewCheque(NewNumeroALetras())
+	lines, err := c.Lines(10, 2, "soles")
+	if err != nil {
+		t.Fatalf("Lines(10) returned error: %v", err)
+	}
+	if lines[1] == strings.Repeat("*", c.Anchos[1]) {
+		t.Errorf("Lines(10) dejó la segunda línea vacía: %q", lines)
+	}
+	if utf8.RuneCountInString(lines[0]) != 60 || utf8.RuneCountInString(lines[1]) != 60 {
+		t.Errorf("Lines(10) = %q; se esperaban líneas de 60 caracteres", lines)
+	}
+}
+
+func TestChequeNoCabe(t *testing.T) {
+	c := NewCheque(NewNumeroALetras())
+	c.Anchos = [2]int{10, 10}
+	if _, err := c.Lines(777777.77, 2, "soles"); err == nil {
+		t.Error("Lines(777777.77) expected error, got nil")
+	}
+	c.Anchos = [2]int{3, 40}
+	if _, err := c.Lines(5, 2, "soles"); err == nil {
+		t.Error("Lines(5) con ancho 3 expected error, got nil")
+	}
+}
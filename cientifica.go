@@ -0,0 +1,85 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type EstiloPotencia int
+
+const (
+	// PotenciaCardinal: POR DIEZ ELEVADO A MENOS SIETE.
+	PotenciaCardinal EstiloPotencia = iota
+	// PotenciaOrdinal: POR DIEZ A LA SÉPTIMA.
+	PotenciaOrdinal
+)
+
+// ToScientific lee value en notación científica con precision cifras
+// decimales en la mantisa (-1 usa las mínimas necesarias, el máximo es 24):
+// 3.2e-7 es TRES COMA DOS POR DIEZ ELEVADO A MENOS SIETE.
+func (n *NumeroALetras) ToScientific(value float64, precision int, style EstiloPotencia) (string, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", fmt.Errorf("numeroaletras: valor inválido %v", value)
+	}
+	if precision < -1 {
+		precision = -1
+	}
+	if precision > maxDigitos {
+		return "", fmt.Errorf("numeroaletras: precisión fuera de rango %d", precision)
+	}
+	c := n.sinApocope()
+	mantissa, exp, _ := strings.Cut(strconv.FormatFloat(value, 'e', precision, 64), "e")
+	exponent, err := strconv.Atoi(exp)
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	if strings.HasPrefix(mantissa, "-") {
+		parts = append(parts, "MENOS")
+		mantissa = mantissa[1:]
+	}
+	whole, fraction, _ := strings.Cut(mantissa, ".")
+	fraction = strings.TrimRight(fraction, "0")
+	parts = append(parts, c.convertDigits(whole))
+	if whole == "0" {
+		parts[len(parts)-1] = "CERO"
+	}
+	if fraction != "" {
+		parts = append(parts, "COMA", c.readGroup(fraction, false))
+	}
+	if whole == "0" || exponent == 0 {
+		return strings.Join(parts, " "), nil
+	}
+
+	parts = append(parts, "POR DIEZ")
+	switch {
+	case exponent == 1:
+	case style == PotenciaOrdinal:
+		ordinal, err := c.ToOrdinal(abs(exponent), Femenino)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, "A LA")
+		if exponent < 0 {
+			parts = append(parts, "MENOS")
+		}
+		parts = append(parts, ordinal)
+	default:
+		parts = append(parts, "ELEVADO A")
+		if exponent < 0 {
+			parts = append(parts, "MENOS")
+		}
+		parts = append(parts, c.convertNumber(abs(exponent)))
+	}
+	return strings.Join(parts, " "), nil
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,53 @@
+package numeroaletras
+
+import (
+	"math"
+	"testing"
+)
+
+func TestToScientific(t *testing.T) {
+	tests := map[string]struct {
+		value     float64
+		precision int
+		style     EstiloPotencia
+		expected  string
+	}{
+		"Exponente negativo":   {value: 3.2e-7, precision: -1, style: PotenciaCardinal, expected: "TRES COMA DOS POR DIEZ ELEVADO A MENOS SIETE"},
+		"Avogadro":             {value: 6.02e23, precision: 2, style: PotenciaCardinal, expected: "SEIS COMA CERO DOS POR DIEZ ELEVADO A VEINTITRÉS"},
+		"Ordinal":              {value: 3.2e-7, precision: 1, style: PotenciaOrdinal, expected: "TRES COMA DOS POR DIEZ A LA MENOS SÉPTIMA"},
+		"Ordinal compuesto":    {value: 6.02e23, precision: 2, style: PotenciaOrdinal, expected: "SEIS COMA CERO DOS POR DIEZ A LA VIGÉSIMA TERCERA"},
+		"Precisión recorta":    {value: 1.23456e5, precision: 2, style: PotenciaCardinal, expected: "UNO COMA VEINTITRÉS POR DIEZ ELEVADO A CINCO"},
+		"Ceros finales":        {value: 2e8, precision: 3, style: PotenciaCardinal, expected: "DOS POR DIEZ ELEVADO A OCHO"},
+		"Exponente uno":        {value: 45, precision: -1, style: PotenciaCardinal, expected: "CUATRO COMA CINCO POR DIEZ"},
+		"Exponente cero":       {value: 7.5, precision: -1, style: PotenciaCardinal, expected: "SIETE COMA CINCO"},
+		"Negativo":             {value: -1.6e-19, precision: -1, style: PotenciaCardinal, expected: "MENOS UNO COMA SEIS POR DIEZ ELEVADO A MENOS DIECINUEVE"},
+		"Cero":                 {value: 0, precision: 2, style: PotenciaCardinal, expected: "CERO"},
+		"Veintiuno no apocop":  {value: 1e21, precision: 0, style: PotenciaCardinal, expected: "UNO POR DIEZ ELEVADO A VEINTIUNO"},
+		"Mantisa de 22 cifras": {value: 1.2345678901234567, precision: 22, style: PotenciaCardinal, expected: "UNO COMA DOS MIL TRESCIENTOS CUARENTA Y CINCO TRILLONES SEISCIENTOS SETENTA Y OCHO MIL NOVECIENTOS UN BILLONES DOSCIENTOS TREINTA Y CUATRO MIL QUINIENTOS SESENTA Y SEIS MILLONES NOVECIENTOS CUATRO MIL TRESCIENTOS VEINTIUNO"},
+	}
+
+	n := NewNumeroALetras()
+	n.UseApocope(true)
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := n.ToScientific(tt.value, tt.precision, tt.style)
+			if err != nil {
+				t.Fatalf("ToScientific(%v) retornó error: %v", tt.value, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToScientific(%v) = %v; se esperaba %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToScientific_Invalido(t *testing.T) {
+	n := NewNumeroALetras()
+	if _, err := n.ToScientific(math.Inf(1), 2, PotenciaCardinal); err == nil {
+		t.Error("ToScientific(+Inf) expected error, got nil")
+	}
+	if _, err := n.ToScientific(1.5, 30, PotenciaCardinal); err == nil {
+		t.Error("ToScientific con precisión 30 expected error, got nil")
+	}
+}
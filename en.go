@@ -0,0 +1,171 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type EnglishStyle int
+
+const (
+	EnglishUS EnglishStyle = iota
+	EnglishUK
+)
+
+// English deletrea en inglés con escala corta (BILLION = 10^9). UseAnd
+// agrega "AND" tras las centenas, como se escribe en el Reino Unido.
+type English struct {
+	Conector string
+	UseAnd   bool
+}
+
+var (
+	englishUnits  = []string{"", "ONE", "TWO", "THREE", "FOUR", "FIVE", "SIX", "SEVEN", "EIGHT", "NINE", "TEN", "ELEVEN", "TWELVE", "THIRTEEN", "FOURTEEN", "FIFTEEN", "SIXTEEN", "SEVENTEEN", "EIGHTEEN", "NINETEEN"}
+	englishTens   = []string{"", "", "TWENTY", "THIRTY", "FORTY", "FIFTY", "SIXTY", "SEVENTY", "EIGHTY", "NINETY"}
+	englishScales = []string{"", "THOUSAND", "MILLION", "BILLION", "TRILLION", "QUADRILLION"}
+)
+
+func NewEnglish(style EnglishStyle) *English {
+	return &English{
+		Conector: "AND",
+		UseAnd:   style == EnglishUK,
+	}
+}
+
+func init() {
+	Register("en", func() Speller { return NewEnglish(EnglishUS) })
+	Register("en-US", func() Speller { return NewEnglish(EnglishUS) })
+	Register("en-GB", func() Speller { return NewEnglish(EnglishUK) })
+}
+
+func (e *English) ToWords(number float64, decimals int) (string, error) {
+	factor := math.Pow(10, float64(decimals))
+	number = math.Round(number*factor) / factor
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := e.wholeNumber(whole)
+	if err != nil {
+		return "", err
+	}
+	var decimal string
+	if fraction != "" {
+		decimal, err = e.wholeNumber(fraction)
+		if err != nil {
+			return "", err
+		}
+		if isZero(fraction) {
+			decimal = ""
+		}
+	}
+	return e.concat(wholeWords, decimal), nil
+}
+
+func (e *English) ToMoney(number float64, decimals int, currency, cents string) (string, error) {
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := e.wholeNumber(whole)
+	if err != nil {
+		return "", err
+	}
+	wholeWords += " " + strings.ToUpper(currency)
+
+	var decimal string
+	if fraction != "" && !isZero(fraction) {
+		decimal, err = e.wholeNumber(fraction)
+		if err != nil {
+			return "", err
+		}
+		decimal += " " + strings.ToUpper(cents)
+	}
+	return e.concat(wholeWords, decimal), nil
+}
+
+func (e *English) ToInvoice(number float64, decimals int, currency string) (string, error) {
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := e.wholeNumber(whole)
+	if err != nil {
+		return "", err
+	}
+	decimal := "00/100"
+	if fraction != "" {
+		decimal = fmt.Sprintf("%02d/100", mustAtoi(fraction))
+	}
+	return e.concat(wholeWords, decimal) + " " + strings.ToUpper(currency), nil
+}
+
+func (e *English) wholeNumber(number string) (string, error) {
+	num, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	if num >= 1e18 {
+		return "", fmt.Errorf("numeroaletras: número fuera de rango %s", number)
+	}
+	return e.convertNumber(num), nil
+}
+
+func (e *English) concat(whole, decimal string) string {
+	if decimal == "" {
+		return whole
+	}
+	return whole + " " + strings.ToUpper(e.Conector) + " " + decimal
+}
+
+func (e *English) convertNumber(num uint64) string {
+	if num == 0 {
+		return "ZERO"
+	}
+
+	var groups []string
+	last := num % 1000
+	for i := 0; num > 0; i++ {
+		g := int(num % 1000)
+		num /= 1000
+		if g == 0 {
+			continue
+		}
+		words := e.convertGroup(g)
+		if i > 0 {
+			words += " " + englishScales[i]
+		}
+		groups = append([]string{words}, groups...)
+	}
+	if e.UseAnd && len(groups) > 1 && last > 0 && last < 100 {
+		groups[len(groups)-1] = "AND " + groups[len(groups)-1]
+	}
+	return strings.Join(groups, " ")
+}
+
+func (e *English) convertGroup(g int) string {
+	h, rest := g/100, g%100
+
+	var parts []string
+	if h > 0 {
+		parts = append(parts, englishUnits[h], "HUNDRED")
+		if rest > 0 && e.UseAnd {
+			parts = append(parts, "AND")
+		}
+	}
+	switch {
+	case rest == 0:
+	case rest < 20:
+		parts = append(parts, englishUnits[rest])
+	case rest%10 == 0:
+		parts = append(parts, englishTens[rest/10])
+	default:
+		parts = append(parts, englishTens[rest/10]+"-"+englishUnits[rest%10])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,124 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestEnglishToWords(t *testing.T) {
+	tests := map[string]struct {
+		style    EnglishStyle
+		number   float64
+		decimals int
+		expected string
+	}{
+		"Zero":              {style: EnglishUS, number: 0, expected: "ZERO"},
+		"Hyphenated tens":   {style: EnglishUS, number: 21, expected: "TWENTY-ONE"},
+		"Round tens":        {style: EnglishUS, number: 90, expected: "NINETY"},
+		"US hundreds":       {style: EnglishUS, number: 123, expected: "ONE HUNDRED TWENTY-THREE"},
+		"UK hundreds":       {style: EnglishUK, number: 123, expected: "ONE HUNDRED AND TWENTY-THREE"},
+		"UK thousand and":   {style: EnglishUK, number: 1005, expected: "ONE THOUSAND AND FIVE"},
+		"US thousand":       {style: EnglishUS, number: 1005, expected: "ONE THOUSAND FIVE"},
+		"Short-scale":       {style: EnglishUS, number: 2000000001, expected: "TWO BILLION ONE"},
+		"Millions":          {style: EnglishUS, number: 1234567, expected: "ONE MILLION TWO HUNDRED THIRTY-FOUR THOUSAND FIVE HUNDRED SIXTY-SEVEN"},
+		"Decimals":          {style: EnglishUS, number: 100.99, decimals: 2, expected: "ONE HUNDRED AND NINETY-NINE"},
+		"Rounded decimals":  {style: EnglishUS, number: 100.9999, decimals: 2, expected: "ONE HUNDRED ONE"},
+		"Zero decimal part": {style: EnglishUS, number: 84, decimals: 4, expected: "EIGHTY-FOUR"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			words, err := NewEnglish(tt.style).ToWords(tt.number, tt.decimals)
+			if err != nil {
+				t.Fatalf("ToWords(%v, %v) returned error: %v", tt.number, tt.decimals, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToWords(%v, %v) = %v; want %v", tt.number, tt.decimals, words, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEnglishToMoney(t *testing.T) {
+	tests := map[string]struct {
+		number   float64
+		currency string
+		cents    string
+		expected string
+	}{
+		"Dollars and cents": {number: 1234.50, currency: "us dollars", cents: "cents", expected: "ONE THOUSAND TWO HUNDRED THIRTY-FOUR US DOLLARS AND FIFTY CENTS"},
+		"No cents":          {number: 10, currency: "US DOLLARS", cents: "CENTS", expected: "TEN US DOLLARS"},
+		"Only cents":        {number: 0.05, currency: "US DOLLARS", cents: "CENTS", expected: "ZERO US DOLLARS AND FIVE CENTS"},
+	}
+
+	e := NewEnglish(EnglishUS)
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			money, err := e.ToMoney(tt.number, 2, tt.currency, tt.cents)
+			if err != nil {
+				t.Fatalf("ToMoney(%v) returned error: %v", tt.number, err)
+			}
+			if money != tt.expected {
+				t.Errorf("ToMoney(%v) = %v; want %v", tt.number, money, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEnglishToInvoice(t *testing.T) {
+	tests := map[string]struct {
+		style    EnglishStyle
+		number   float64
+		currency string
+		expected string
+	}{
+		"Check US":   {style: EnglishUS, number: 1234.50, currency: "us dollars", expected: "ONE THOUSAND TWO HUNDRED THIRTY-FOUR AND 50/100 US DOLLARS"},
+		"Check UK":   {style: EnglishUK, number: 101.05, currency: "pounds", expected: "ONE HUNDRED AND ONE AND 05/100 POUNDS"},
+		"Zero cents": {style: EnglishUS, number: 17, currency: "US DOLLARS", expected: "SEVENTEEN AND 00/100 US DOLLARS"},
+		"Rounding":   {style: EnglishUS, number: 599.999, currency: "US DOLLARS", expected: "SIX HUNDRED AND 00/100 US DOLLARS"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			invoice, err := NewEnglish(tt.style).ToInvoice(tt.number, 2, tt.currency)
+			if err != nil {
+				t.Fatalf("ToInvoice(%v) returned error: %v", tt.number, err)
+			}
+			if invoice != tt.expected {
+				t.Errorf("ToInvoice(%v) = %v; want %v", tt.number, invoice, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEnglishErrors(t *testing.T) {
+	e := NewEnglish(EnglishUS)
+	if _, err := e.ToWords(-5, 0); err == nil || err.Error() != "numeroaletras: número negativo -5" {
+		t.Errorf("ToWords(-5) = %v; want numeroaletras: número negativo -5", err)
+	}
+	if words, err := e.ToWords(-0.2, 0); err != nil || words != "ZERO" {
+		t.Errorf("ToWords(-0.2, 0) = %v, %v; want ZERO", words, err)
+	}
+	if money, err := e.ToMoney(-0.5, 2, "us dollars", "cents"); err == nil || err.Error() != "numeroaletras: número negativo -0.50" {
+		t.Errorf("ToMoney(-0.5) = %v, %v; want numeroaletras: número negativo -0.50", money, err)
+	}
+	if invoice, err := e.ToInvoice(-0.5, 2, "us dollars"); err == nil || err.Error() != "numeroaletras: número negativo -0.50" {
+		t.Errorf("ToInvoice(-0.5) = %v, %v; want numeroaletras: número negativo -0.50", invoice, err)
+	}
+	if _, err := e.ToWords(1e18, 0); err == nil {
+		t.Error("ToWords(1e18) expected error, got nil")
+	}
+}
+
+func TestEnglishLocales(t *testing.T) {
+	s, err := NewSpeller("en-GB")
+	if err != nil {
+		t.Fatalf("NewSpeller(en-GB) returned error: %v", err)
+	}
+	words, _ := s.ToWords(110, 0)
+	if words != "ONE HUNDRED AND TEN" {
+		t.Errorf("en-GB ToWords(110) = %v", words)
+	}
+}
@@ -0,0 +1,159 @@
+package numeroaletras
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ErrAmbiguo indica que un único separador seguido de tres cifras (1.234)
+// puede ser de millares o decimal. Se resuelve con FormatoNumerico.Parse.
+var ErrAmbiguo = errors.New("numeroaletras: separador ambiguo")
+
+// ParseCifras lee un importe tal como llega de hojas de cálculo u OCR
+// (S/ 1,250.50, 1.234.567,89 €, US$ 20) deduciendo los separadores. Cuando
+// hay un símbolo o código conocido, la moneda del resultado es la suya.
+func ParseCifras(s string) (Amount, error) {
+	return FormatoNumerico{}.parse(s, false)
+}
+
+// Parse lee un importe con los separadores del formato, sin adivinar.
+func (f FormatoNumerico) Parse(s string) (Amount, error) {
+	return f.parse(s, true)
+}
+
+func (f FormatoNumerico) parse(s string, estricto bool) (Amount, error) {
+	body := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	negative := false
+	if strings.HasPrefix(body, "-") {
+		negative, body = true, body[1:]
+	}
+	currency, body := f.moneda(body)
+	if strings.HasPrefix(body, "-") && !negative {
+		negative, body = true, body[1:]
+	}
+	if body == "" || strings.Trim(body, "0123456789.,") != "" {
+		return Amount{}, fmt.Errorf("numeroaletras: importe inválido %q", s)
+	}
+
+	miles, decimal := f.Miles, f.Decimal
+	if !estricto {
+		var err error
+		if miles, decimal, err = separadores(body); err != nil {
+			return Amount{}, err
+		}
+	}
+	for _, r := range body {
+		if !unicode.IsDigit(r) && string(r) != miles && string(r) != decimal {
+			return Amount{}, fmt.Errorf("numeroaletras: separador %q no válido en %q", r, s)
+		}
+	}
+
+	whole, fraction, hasFraction := body, "", false
+	if decimal != "" {
+		whole, fraction, hasFraction = strings.Cut(body, decimal)
+	}
+	if hasFraction && (fraction == "" || !isDigits(fraction)) {
+		return Amount{}, fmt.Errorf("numeroaletras: decimales inválidos en %q", s)
+	}
+	if miles != "" && strings.Contains(whole, miles) {
+		groups := strings.Split(whole, miles)
+		for i, g := range groups {
+			if (i == 0 && (len(g) < 1 || len(g) > 3)) || (i > 0 && len(g) != 3) {
+				return Amount{}, fmt.Errorf("numeroaletras: separador de millares mal ubicado en %q", s)
+			}
+		}
+		whole = strings.Join(groups, "")
+	}
+
+	value := whole
+	if negative {
+		value = "-" + value
+	}
+	if hasFraction {
+		value += "." + fraction
+	}
+	a, err := ParseAmount(value, currency)
+	if err != nil {
+		return Amount{}, fmt.Errorf("numeroaletras: importe inválido %q", s)
+	}
+	return a, nil
+}
+
+// separadores deduce cuál es el separador de millares y cuál el decimal.
+// Si aparecen los dos, el último es el decimal; si solo aparece uno varias
+// veces, es de millares.
+func separadores(body string) (miles, decimal string, err error) {
+	puntos, comas := strings.Count(body, "."), strings.Count(body, ",")
+	switch {
+	case puntos > 0 && comas > 0:
+		if strings.LastIndex(body, ".") > strings.LastIndex(body, ",") {
+			return ",", ".", nil
+		}
+		return ".", ",", nil
+	case puntos > 1:
+		return ".", "", nil
+	case comas > 1:
+		return ",", "", nil
+	case puntos == 0 && comas == 0:
+		return "", "", nil
+	}
+
+	sep := "."
+	if comas == 1 {
+		sep = ","
+	}
+	whole, fraction, _ := strings.Cut(body, sep)
+	if len(fraction) != 3 || len(whole) > 3 || whole == "" || whole[0] == '0' {
+		return "", sep, nil
+	}
+	return "", "", fmt.Errorf("%w: %s%s%s puede ser %s%s o %s.%s", ErrAmbiguo, whole, sep, fraction, whole, fraction, whole, fraction)
+}
+
+// moneda quita un símbolo o código de moneda al inicio o al final.
+func (f FormatoNumerico) moneda(body string) (Currency, string) {
+	simbolos := map[string]string{"S/.": "PEN", "$": "USD"}
+	for code, simbolo := range simbolosMoneda {
+		simbolos[simbolo] = code
+	}
+	for code, simbolo := range f.Simbolos {
+		simbolos[simbolo] = code
+	}
+	for code := range currencies {
+		simbolos[code] = code
+	}
+
+	keys := make([]string, 0, len(simbolos))
+	for k := range simbolos {
+		keys = append(keys, k)
+	}
+	// Primero los más largos, para que US$ no se lea como $.
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+
+	upper := strings.ToUpper(body)
+	for _, k := range keys {
+		var rest string
+		switch {
+		case strings.HasPrefix(upper, strings.ToUpper(k)):
+			rest = body[len(k):]
+		case strings.HasSuffix(upper, strings.ToUpper(k)):
+			rest = body[:len(body)-len(k)]
+		default:
+			continue
+		}
+		c, ok := CurrencyByCode(simbolos[k])
+		if !ok {
+			c = Currency{Code: simbolos[k]}
+		}
+		return c, rest
+	}
+	return Currency{}, body
+}
@@ -0,0 +1,93 @@
+package numeroaletras
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseCifras(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+		currency string
+	}{
+		"Coma de millares":        {input: "1,234,567.89", expected: "1234567.89"},
+		"Punto de millares":       {input: "1.234.567,89", expected: "1234567.89"},
+		"Solo millares":           {input: "1.234.567", expected: "1234567"},
+		"Coma decimal":            {input: "3,5", expected: "3.5"},
+		"Cero con tres decimales": {input: "0.125", expected: "0.125"},
+		"Sin agrupar":             {input: "1234.567", expected: "1234.567"},
+		"Soles":                   {input: "S/ 1,250.50", expected: "1250.50", currency: "PEN"},
+		"Soles con punto":         {input: "S/. 20", expected: "20", currency: "PEN"},
+		"Dólares":                 {input: "US$20.00", expected: "20.00", currency: "USD"},
+		"Euros al final":          {input: "1.250,50 €", expected: "1250.50", currency: "EUR"},
+		"Código":                  {input: "-1 250,00 eur", expected: "-1250.00", currency: "EUR"},
+		"Espacio duro":            {input: "12 345,6", expected: "12345.6"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			a, err := ParseCifras(tt.input)
+			if err != nil {
+				t.Fatalf("ParseCifras(%q) returned error: %v", tt.input, err)
+			}
+			if a.String() != tt.expected || a.Currency.Code != tt.currency {
+				t.Errorf("ParseCifras(%q) = %v %v; want %v %v", tt.input, a, a.Currency.Code, tt.expected, tt.currency)
+			}
+		})
+	}
+}
+
+func TestParseCifras_Errores(t *testing.T) {
+	if _, err := ParseCifras("1.234"); !errors.Is(err, ErrAmbiguo) {
+		t.Errorf("ParseCifras(1.234) = %v; want ErrAmbiguo", err)
+	}
+	for _, input := range []string{"", "abc", "1,23,456", "12.34.5", "1.234,5,6", "S/", "1.2.3,4"} {
+		if _, err := ParseCifras(input); err == nil {
+			t.Errorf("ParseCifras(%q) expected error, got nil", input)
+		}
+	}
+}
+
+func TestFormatoNumerico_Parse(t *testing.T) {
+	tests := map[string]struct {
+		formato  FormatoNumerico
+		input    string
+		expected string
+	}{
+		"España resuelve 1.234": {formato: FormatoEspana, input: "1.234", expected: "1234"},
+		"Perú resuelve 1.234":   {formato: FormatoPeru, input: "1.234", expected: "1.234"},
+		"México con símbolo":    {formato: FormatoMexico, input: "$1,000.5", expected: "1000.5"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			a, err := tt.formato.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.input, err)
+			}
+			if a.String() != tt.expected {
+				t.Errorf("Parse(%q) = %v; want %v", tt.input, a, tt.expected)
+			}
+		})
+	}
+
+	a, _ := FormatoMexico.Parse("$1,000.5")
+	if a.Currency.Code != "MXN" {
+		t.Errorf("FormatoMexico.Parse($) currency = %v; want MXN", a.Currency.Code)
+	}
+	if _, err := FormatoPeru.Parse("1.234,50"); err == nil {
+		t.Error("FormatoPeru.Parse(1.234,50) expected error, got nil")
+	}
+
+	a, _ = ParseCifras("S/ 1,000,000.01")
+	if words, _ := a.Words(); words != "UN MILLÓN SOLES CON UNO CÉNTIMOS" {
+		t.Errorf("Words = %v", words)
+	}
+	a, _ = ParseCifras("S/ 1.250,5")
+	if words, _ := a.Words(); words != "MIL DOSCIENTOS CINCUENTA SOLES CON CINCUENTA CÉNTIMOS" {
+		t.Errorf("Words(S/ 1.250,5) = %v", words)
+	}
+}
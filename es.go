@@ -0,0 +1,68 @@
+package numeroaletras
+
+var _ Speller = (*NumeroALetras)(nil)
+
+// tablasEspanol son las grafías de una variante del castellano. El núcleo
+// solo combina grupos y escalas; los textos viven aquí, con el idioma.
+type tablasEspanol struct {
+	unidades []string
+	decenas  []string
+	centenas []string
+	acentos  map[string]string
+}
+
+var centenasEspanol = []string{"CIENTO ", "DOSCIENTOS ", "TRESCIENTOS ", "CUATROCIENTOS ", "QUINIENTOS ", "SEISCIENTOS ", "SETECIENTOS ", "OCHOCIENTOS ", "NOVECIENTOS "}
+
+var (
+	espanolRAE = tablasEspanol{
+		unidades: []string{"", "UNO ", "DOS ", "TRES ", "CUATRO ", "CINCO ", "SEIS ", "SIETE ", "OCHO ", "NUEVE ", "DIEZ ", "ONCE ", "DOCE ", "TRECE ", "CATORCE ", "QUINCE ", "DIECISÉIS ", "DIECISIETE ", "DIECIOCHO ", "DIECINUEVE ", "VEINTE "},
+		decenas:  []string{"VEINTI", "TREINTA ", "CUARENTA ", "CINCUENTA ", "SESENTA ", "SETENTA ", "OCHENTA ", "NOVENTA ", "CIEN "},
+		centenas: centenasEspanol,
+		acentos:  map[string]string{"VEINTIDOS": "VEINTIDÓS ", "VEINTITRES": "VEINTITRÉS ", "VEINTISEIS": "VEINTISÉIS ", "VEINTIUN": "VEINTIÚN "},
+	}
+	espanolAntiguo = tablasEspanol{
+		unidades: []string{"", "UNO ", "DOS ", "TRES ", "CUATRO ", "CINCO ", "SEIS ", "SIETE ", "OCHO ", "NUEVE ", "DIEZ ", "ONCE ", "DOCE ", "TRECE ", "CATORCE ", "QUINCE ", "DIEZ Y SEIS ", "DIEZ Y SIETE ", "DIEZ Y OCHO ", "DIEZ Y NUEVE ", "VEINTE "},
+		decenas:  []string{"VEINTE Y ", "TREINTA ", "CUARENTA ", "CINCUENTA ", "SESENTA ", "SETENTA ", "OCHENTA ", "NOVENTA ", "CIEN "},
+		centenas: centenasEspanol,
+		acentos:  map[string]string{},
+	}
+)
+
+// RegionEspanol reúne lo que cambia entre los países hispanohablantes. Cada
+// etiqueta es-XX registrada construye su Speller a partir de una de estas
+// configuraciones, y una región nueva se agrega sin tocar el núcleo:
+//
+//	numeroaletras.Register("es-AR", numeroaletras.RegionEspanol{Conector: "CON"}.Speller)
+type RegionEspanol struct {
+	Conector string
+	Variante Variante
+	Escala   Escala
+	Apocope  bool
+}
+
+// New devuelve un conversor configurado para la región.
+func (r RegionEspanol) New() *NumeroALetras {
+	n := &NumeroALetras{Conector: r.Conector, apocope: r.Apocope, escala: r.Escala}
+	n.UseVariante(r.Variante)
+	return n
+}
+
+func (r RegionEspanol) Speller() Speller {
+	return r.New()
+}
+
+var regionesEspanol = map[string]RegionEspanol{
+	"es":    {Conector: "CON"},
+	"es-PE": {Conector: "CON"},
+	"es-MX": {Conector: "CON"},
+	"es-ES": {Conector: "CON"},
+	// Puerto Rico y EE. UU. leen 10^9 como BILLÓN, como en inglés.
+	"es-PR": {Conector: "CON", Escala: EscalaCorta},
+	"es-US": {Conector: "CON", Escala: EscalaCorta},
+}
+
+func init() {
+	for tag, r := range regionesEspanol {
+		Register(tag, r.Speller)
+	}
+}
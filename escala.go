@@ -0,0 +1,36 @@
+package numeroaletras
+
+type Escala int
+
+const (
+	// EscalaLarga: 10^9 es MIL MILLONES y 10^12 BILLÓN (Perú, España).
+	EscalaLarga Escala = iota
+	// EscalaCorta: 10^9 es BILLÓN, calco del inglés (Puerto Rico, EE. UU.).
+	EscalaCorta
+)
+
+var escalasCortas = [][2]string{{"", ""}, {"MIL", "MIL"}, {"MILLÓN", "MILLONES"}, {"BILLÓN", "BILLONES"}, {"TRILLÓN", "TRILLONES"}, {"CUATRILLÓN", "CUATRILLONES"}, {"QUINTILLÓN", "QUINTILLONES"}, {"SEXTILLÓN", "SEXTILLONES"}}
+
+func (n *NumeroALetras) UseEscala(e Escala) {
+	n.escala = e
+}
+
+// escribirEscalaCorta deletrea en grupos de tres cifras, cada uno con su
+// propio nombre por encima del millar.
+func (n *NumeroALetras) escribirEscalaCorta(s *salida, digits []byte) {
+	groups := (len(digits) + 2) / 3
+	for i := 0; i < groups; i++ {
+		escala := groups - 1 - i
+		end := len(digits) - escala*3
+		group := digits[max(end-3, 0):end]
+		switch {
+		case isZero(group):
+		case escala == 0:
+			n.escribirGrupo(s, group, n.apocope)
+		case escala == 1:
+			n.escribirMiles(s, group, nil, true)
+		default:
+			n.escribirEscala(s, group, escalasCortas[escala][0], escalasCortas[escala][1])
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestEscalas(t *testing.T) {
+	tests := map[string]struct {
+		escala   Escala
+		number   float64
+		expected string
+	}{
+		"Larga mil millones":   {escala: EscalaLarga, number: 1e9, expected: "MIL MILLONES"},
+		"Corta un billón":      {escala: EscalaCorta, number: 1e9, expected: "UN BILLÓN"},
+		"Corta billones":       {escala: EscalaCorta, number: 2500000000, expected: "DOS BILLONES QUINIENTOS MILLONES"},
+		"Corta veintiún":       {escala: EscalaCorta, number: 21e9, expected: "VEINTIÚN BILLONES"},
+		"Larga un billón":      {escala: EscalaLarga, number: 1e12, expected: "UN BILLÓN"},
+		"Corta un trillón":     {escala: EscalaCorta, number: 1e12, expected: "UN TRILLÓN"},
+		"Corta millones":       {escala: EscalaCorta, number: 1001001, expected: "UN MILLÓN MIL UNO"},
+		"Corta debajo del mil": {escala: EscalaCorta, number: 999, expected: "NOVECIENTOS NOVENTA Y NUEVE"},
+		"Corta mil un":         {escala: EscalaCorta, number: 201000, expected: "DOSCIENTOS UN MIL"},
+		"Corta cuatrillón":     {escala: EscalaCorta, number: 3e15, expected: "TRES CUATRILLONES"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			n := NewNumeroALetras()
+			n.UseEscala(tt.escala)
+			words, err := n.ToWords(tt.number, 0)
+			if err != nil {
+				t.Fatalf("ToWords(%v) retornó error: %v", tt.number, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToWords(%v) = %v; se esperaba %v", tt.number, words, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEscalaPorRegion(t *testing.T) {
+	tests := map[string]string{
+		"es-PE": "DOS MIL MILLONES SOLES",
+		"es-PR": "DOS BILLONES SOLES",
+		"es-US": "DOS BILLONES SOLES",
+	}
+	for tag, expected := range tests {
+		s, err := NewSpeller(tag)
+		if err != nil {
+			t.Fatalf("NewSpeller(%q) retornó error: %v", tag, err)
+		}
+		money, _ := s.ToMoney(2e9, 2, "SOLES", "CENTIMOS")
+		if money != expected {
+			t.Errorf("NewSpeller(%q).ToMoney(2e9) = %v; se esperaba %v", tag, money, expected)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Regla int
+
+const (
+	// ReglaApocope: UNO pierde la -O delante de MIL, de un nombre de escala o
+	// de un sustantivo (UN MILLÓN, VEINTIÚN MIL).
+	ReglaApocope Regla = iota
+	// ReglaAcento: VEINTIDÓS, VEINTITRÉS, VEINTISÉIS y VEINTIÚN llevan tilde.
+	ReglaAcento
+	// ReglaConjuncion: desde TREINTA Y UNO, decenas y unidades se unen con Y;
+	// en la variante antigua también VEINTE Y UNO y DIEZ Y SEIS.
+	ReglaConjuncion
+	// ReglaCien: la centena sola se dice CIEN.
+	ReglaCien
+	// ReglaCiento: seguida de decenas o unidades, la centena es CIENTO.
+	ReglaCiento
+	// ReglaMil: MIL no lleva UN delante.
+	ReglaMil
+	// ReglaSingular: tras UN el nombre de escala va en singular (UN MILLÓN).
+	ReglaSingular
+)
+
+var reglas = []string{
+	"apócope de UNO en UN",
+	"tilde de VEINTIDÓS, VEINTITRÉS, VEINTISÉIS y VEINTIÚN",
+	"Y entre decenas y unidades",
+	"CIEN para la centena sola",
+	"CIENTO ante decenas o unidades",
+	"MIL sin UN delante",
+	"escala en singular tras UN",
+}
+
+func (r Regla) String() string {
+	if r < 0 || int(r) >= len(reglas) {
+		return fmt.Sprintf("Regla(%d)", int(r))
+	}
+	return reglas[r]
+}
+
+// Paso es un grupo de hasta tres cifras con el nombre de escala que lo sigue
+// (MIL, MILLONES...) y las reglas aplicadas al deletrearlo. Un MIL sin
+// multiplicador es un paso con Cifras "1" y Palabras vacías.
+type Paso struct {
+	Cifras   string
+	Palabras string
+	Escala   string
+	Reglas   []Regla
+}
+
+type Explicacion struct {
+	Numero    string
+	Pasos     []Paso
+	Resultado string
+}
+
+// Explain descompone number en los grupos que deletrea el conversor, en el
+// mismo orden, indicando qué reglas se aplicaron en cada uno.
+func (n *NumeroALetras) Explain(number int) (Explicacion, error) {
+	if number < 0 {
+		return Explicacion{}, fmt.Errorf("numeroaletras: número fuera de rango %d", number)
+	}
+	digits := strconv.Itoa(number)
+	if number == 0 {
+		return Explicacion{
+			Numero:    digits,
+			Pasos:     []Paso{{Cifras: "0", Palabras: "CERO"}},
+			Resultado: "CERO",
+		}, nil
+	}
+
+	tokens := n.digitTokens(digits)
+	var pasos []Paso
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if t.Kind == TokenScale && t.Digits == "" && len(pasos) > 0 {
+			// MIL MILLONES: la escala se suma a la del paso anterior.
+			pasos[len(pasos)-1].Escala += " " + t.Text
+			continue
+		}
+		if t.Kind == TokenScale {
+			// MIL sin multiplicador.
+			pasos = append(pasos, Paso{Cifras: t.Digits, Escala: t.Text, Reglas: []Regla{ReglaMil}})
+			continue
+		}
+		paso := Paso{Cifras: t.Digits, Palabras: t.Text}
+		apocope := n.apocope
+		if i+1 < len(tokens) && tokens[i+1].Kind == TokenScale {
+			i++
+			paso.Escala = tokens[i].Text
+			apocope = true
+		}
+		group := strings.Repeat("0", 3-len(t.Digits)) + t.Digits
+		_, paso.Reglas = n.explainGroup(group, apocope)
+		// En MIL UN MILLONES la escala sigue en plural y la regla no aplica.
+		if t.Digits == "1" && paso.Escala != "" && paso.Escala != "MIL" && !strings.HasSuffix(paso.Escala, "S") {
+			paso.Reglas = append(paso.Reglas, ReglaSingular)
+		}
+		pasos = append(pasos, paso)
+	}
+	return Explicacion{Numero: digits, Pasos: pasos, Resultado: Render(tokens)}, nil
+}
@@ -0,0 +1,108 @@
+package numeroaletras
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	n := NewNumeroALetras()
+
+	tests := map[string]struct {
+		number    int
+		expected  []Paso
+		resultado string
+	}{
+		"Millones, miles y unidades": {
+			number: 1234567,
+			expected: []Paso{
+				{Cifras: "1", Palabras: "UN", Escala: "MILLÓN", Reglas: []Regla{ReglaApocope, ReglaSingular}},
+				{Cifras: "234", Palabras: "DOSCIENTOS TREINTA Y CUATRO", Escala: "MIL", Reglas: []Regla{ReglaConjuncion}},
+				{Cifras: "567", Palabras: "QUINIENTOS SESENTA Y SIETE", Reglas: []Regla{ReglaConjuncion}},
+			},
+			resultado: "UN MILLÓN DOSCIENTOS TREINTA Y CUATRO MIL QUINIENTOS SESENTA Y SIETE",
+		},
+		"Mil un millones": {
+			number: 1001000021,
+			expected: []Paso{
+				{Cifras: "1", Escala: "MIL", Reglas: []Regla{ReglaMil}},
+				{Cifras: "1", Palabras: "UN", Escala: "MILLONES", Reglas: []Regla{ReglaApocope}},
+				{Cifras: "21", Palabras: "VEINTIUNO"},
+			},
+			resultado: "MIL UN MILLONES VEINTIUNO",
+		},
+		"Cien y ciento": {
+			number: 121100,
+			expected: []Paso{
+				{Cifras: "121", Palabras: "CIENTO VEINTIÚN", Escala: "MIL", Reglas: []Regla{ReglaApocope, ReglaCiento, ReglaAcento}},
+				{Cifras: "100", Palabras: "CIEN", Reglas: []Regla{ReglaCien}},
+			},
+			resultado: "CIENTO VEINTIÚN MIL CIEN",
+		},
+		"Mil sin un": {
+			number: 1022,
+			expected: []Paso{
+				{Cifras: "1", Escala: "MIL", Reglas: []Regla{ReglaMil}},
+				{Cifras: "22", Palabras: "VEINTIDÓS", Reglas: []Regla{ReglaAcento}},
+			},
+			resultado: "MIL VEINTIDÓS",
+		},
+		"Mil millones": {
+			number: 21000000000,
+			expected: []Paso{
+				{Cifras: "21", Palabras: "VEINTIÚN", Escala: "MIL MILLONES", Reglas: []Regla{ReglaApocope, ReglaAcento}},
+			},
+			resultado: "VEINTIÚN MIL MILLONES",
+		},
+		"Cero": {
+			number:    0,
+			expected:  []Paso{{Cifras: "0", Palabras: "CERO"}},
+			resultado: "CERO",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			res, err := n.Explain(tt.number)
+			if err != nil {
+				t.Fatalf("Explain returned error: %v", err)
+			}
+			if !reflect.DeepEqual(res.Pasos, tt.expected) {
+				t.Errorf("Explain(%d).Pasos = %+v; want %+v", tt.number, res.Pasos, tt.expected)
+			}
+			if res.Resultado != tt.resultado {
+				t.Errorf("Explain(%d).Resultado = %v; want %v", tt.number, res.Resultado, tt.resultado)
+			}
+		})
+	}
+
+	if _, err := n.Explain(-1); err == nil {
+		t.Error("Explain(-1) expected error, got nil")
+	}
+}
+
+func TestExplain_UnoFinal(t *testing.T) {
+	n := NewNumeroALetras()
+	res, _ := n.Explain(31)
+	if expected := []Regla{ReglaConjuncion}; !reflect.DeepEqual(res.Pasos[0].Reglas, expected) {
+		t.Errorf("Explain(31) sin apócope = %v; want %v", res.Pasos[0].Reglas, expected)
+	}
+
+	n.UseApocope(true)
+	res, _ = n.Explain(31)
+	if expected := []Regla{ReglaApocope, ReglaConjuncion}; !reflect.DeepEqual(res.Pasos[0].Reglas, expected) {
+		t.Errorf("Explain(31) con apócope = %v; want %v", res.Pasos[0].Reglas, expected)
+	}
+	n.UseVariante(VarianteAntigua)
+	for _, number := range []int{21, 16} {
+		res, _ = n.Explain(number)
+		if !slices.Contains(res.Pasos[0].Reglas, ReglaConjuncion) {
+			t.Errorf("Explain(%d) variante antigua = %v %v; want %v", number, res.Resultado, res.Pasos[0].Reglas, ReglaConjuncion)
+		}
+	}
+	if got := ReglaConjuncion.String(); got != "Y entre decenas y unidades" {
+		t.Errorf("ReglaConjuncion.String() = %v", got)
+	}
+}
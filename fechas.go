@@ -0,0 +1,69 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"time"
+)
+
+type EstiloFecha int
+
+const (
+	// FechaCorta: DIECIOCHO DE OCTUBRE DE DOS MIL VEINTISÉIS.
+	FechaCorta EstiloFecha = iota
+	// FechaLarga antepone el día de la semana: DOMINGO, DIECIOCHO DE ...
+	FechaLarga
+	// FechaNotarial: A LOS DIECIOCHO DÍAS DEL MES DE OCTUBRE DEL AÑO ...
+	FechaNotarial
+)
+
+// FechaPrimero se combina con cualquier estilo para escribir el día uno
+// como ordinal: PRIMERO DE MAYO.
+const FechaPrimero EstiloFecha = 1 << 4
+
+var (
+	meses = []string{"", "ENERO", "FEBRERO", "MARZO", "ABRIL", "MAYO", "JUNIO", "JULIO", "AGOSTO", "SETIEMBRE", "OCTUBRE", "NOVIEMBRE", "DICIEMBRE"}
+	dias  = []string{"DOMINGO", "LUNES", "MARTES", "MIÉRCOLES", "JUEVES", "VIERNES", "SÁBADO"}
+)
+
+func NombreMes(m time.Month) string {
+	if m < time.January || m > time.December {
+		return ""
+	}
+	return meses[m]
+}
+
+func NombreDia(d time.Weekday) string {
+	if d < time.Sunday || d > time.Saturday {
+		return ""
+	}
+	return dias[d]
+}
+
+func (n *NumeroALetras) ToDate(t time.Time, style EstiloFecha) (string, error) {
+	if t.Year() < 1 {
+		return "", fmt.Errorf("numeroaletras: año fuera de rango %d", t.Year())
+	}
+	c := n.sinApocope()
+	year := c.convertNumber(t.Year())
+	month := NombreMes(t.Month())
+	primero := style&FechaPrimero != 0 && t.Day() == 1
+
+	day := c.convertNumber(t.Day())
+	if primero {
+		day = "PRIMERO"
+	}
+
+	switch style &^ FechaPrimero {
+	case FechaLarga:
+		return fmt.Sprintf("%s, %s DE %s DE %s", NombreDia(t.Weekday()), day, month, year), nil
+	case FechaNotarial:
+		if t.Day() == 1 {
+			return fmt.Sprintf("AL PRIMER DÍA DEL MES DE %s DEL AÑO %s", month, year), nil
+		}
+		a := *n
+		a.apocope = true
+		return fmt.Sprintf("A LOS %s DÍAS DEL MES DE %s DEL AÑO %s", a.convertNumber(t.Day()), month, year), nil
+	default:
+		return fmt.Sprintf("%s DE %s DE %s", day, month, year), nil
+	}
+}
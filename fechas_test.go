@@ -0,0 +1,86 @@
+package numeroaletras
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToDate(t *testing.T) {
+	tests := map[string]struct {
+		date     time.Time
+		style    EstiloFecha
+		apocope  bool
+		expected string
+	}{
+		"Corta": {
+			date:     time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+			style:    FechaCorta,
+			expected: "DIECIOCHO DE OCTUBRE DE DOS MIL VEINTISÉIS",
+		},
+		"Larga": {
+			date:     time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+			style:    FechaLarga,
+			expected: "DOMINGO, DIECIOCHO DE OCTUBRE DE DOS MIL VEINTISÉIS",
+		},
+		"Notarial": {
+			date:     time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+			style:    FechaNotarial,
+			expected: "A LOS DIECIOCHO DÍAS DEL MES DE OCTUBRE DEL AÑO DOS MIL VEINTISÉIS",
+		},
+		"Notarial veintiún días": {
+			date:     time.Date(2021, time.May, 21, 0, 0, 0, 0, time.UTC),
+			style:    FechaNotarial,
+			expected: "A LOS VEINTIÚN DÍAS DEL MES DE MAYO DEL AÑO DOS MIL VEINTIUNO",
+		},
+		"Notarial primer día": {
+			date:     time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC),
+			style:    FechaNotarial,
+			expected: "AL PRIMER DÍA DEL MES DE MAYO DEL AÑO DOS MIL VEINTISÉIS",
+		},
+		"Día uno cardinal": {
+			date:     time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC),
+			style:    FechaCorta,
+			expected: "UNO DE MAYO DE DOS MIL VEINTISÉIS",
+		},
+		"Primero de mayo": {
+			date:     time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC),
+			style:    FechaCorta | FechaPrimero,
+			expected: "PRIMERO DE MAYO DE DOS MIL VEINTISÉIS",
+		},
+		"Larga primero": {
+			date:     time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC),
+			style:    FechaLarga | FechaPrimero,
+			expected: "VIERNES, PRIMERO DE MAYO DE DOS MIL VEINTISÉIS",
+		},
+		"Apócope no afecta año": {
+			date:     time.Date(2021, time.September, 21, 0, 0, 0, 0, time.UTC),
+			style:    FechaCorta,
+			apocope:  true,
+			expected: "VEINTIUNO DE SETIEMBRE DE DOS MIL VEINTIUNO",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			n := NewNumeroALetras()
+			n.UseApocope(tt.apocope)
+			words, err := n.ToDate(tt.date, tt.style)
+			if err != nil {
+				t.Fatalf("ToDate(%v) retornó error: %v", tt.date, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToDate(%v) = %v; se esperaba %v", tt.date, words, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNombres(t *testing.T) {
+	if NombreMes(time.February) != "FEBRERO" || NombreMes(13) != "" {
+		t.Errorf("NombreMes devolvió valores inesperados")
+	}
+	if NombreDia(time.Wednesday) != "MIÉRCOLES" || NombreDia(7) != "" {
+		t.Errorf("NombreDia devolvió valores inesperados")
+	}
+}
@@ -0,0 +1,111 @@
+package numeroaletras
+
+import (
+	"strings"
+)
+
+// FormatoNumerico describe cómo escribe las cifras cada país: separadores de
+// millares y decimales y dónde va el símbolo de la moneda. Simbolos reemplaza
+// el símbolo por defecto de una moneda por su código.
+type FormatoNumerico struct {
+	Miles          string
+	Decimal        string
+	SimboloDespues bool
+	Espacio        bool
+	Simbolos       map[string]string
+}
+
+var (
+	FormatoPeru      = FormatoNumerico{Miles: ",", Decimal: ".", Espacio: true}
+	FormatoMexico    = FormatoNumerico{Miles: ",", Decimal: ".", Simbolos: map[string]string{"MXN": "$"}}
+	FormatoEEUU      = FormatoNumerico{Miles: ",", Decimal: ".", Simbolos: map[string]string{"USD": "$"}}
+	FormatoEspana    = FormatoNumerico{Miles: ".", Decimal: ",", SimboloDespues: true, Espacio: true}
+	FormatoColombia  = FormatoNumerico{Miles: ".", Decimal: ",", Espacio: true, Simbolos: map[string]string{"COP": "$"}}
+	FormatoArgentina = FormatoNumerico{Miles: ".", Decimal: ",", Espacio: true, Simbolos: map[string]string{"ARS": "$"}}
+)
+
+var formatosNumericos = map[string]FormatoNumerico{
+	"es":    FormatoPeru,
+	"es-PE": FormatoPeru,
+	"es-MX": FormatoMexico,
+	"es-US": FormatoEEUU,
+	"en-US": FormatoEEUU,
+	"es-ES": FormatoEspana,
+	"es-CO": FormatoColombia,
+	"es-AR": FormatoArgentina,
+}
+
+// FormatoPara devuelve el formato de cifras de un locale, con la misma
+// búsqueda por subetiquetas que NewSpeller.
+func FormatoPara(tag string) (FormatoNumerico, bool) {
+	tag = canonicalTag(tag)
+	for tag != "" {
+		if f, ok := formatosNumericos[tag]; ok {
+			return f, true
+		}
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			break
+		}
+		tag = tag[:i]
+	}
+	return FormatoNumerico{}, false
+}
+
+var simbolosMoneda = map[string]string{"PEN": "S/", "USD": "US$", "EUR": "€", "MXN": "MX$"}
+
+func (f FormatoNumerico) Simbolo(code string) string {
+	if s, ok := f.Simbolos[code]; ok {
+		return s
+	}
+	if s, ok := simbolosMoneda[code]; ok {
+		return s
+	}
+	return code
+}
+
+// Cifras escribe el importe con separadores y símbolo: S/ 1,250.50,
+// 1.250,50 €.
+func (f FormatoNumerico) Cifras(a Amount) string {
+	digits := f.agrupar(a.wholeDigits())
+	if a.fraction != "" {
+		digits += f.Decimal + a.fraction
+	}
+
+	sep := ""
+	if f.Espacio {
+		sep = " "
+	}
+	simbolo := f.Simbolo(a.Currency.Code)
+	var res string
+	switch {
+	case simbolo == "":
+		res = digits
+	case f.SimboloDespues:
+		res = digits + sep + simbolo
+	default:
+		res = simbolo + sep + digits
+	}
+	if a.negative {
+		res = "-" + res
+	}
+	return res
+}
+
+func (f FormatoNumerico) agrupar(digits string) string {
+	if f.Miles == "" || len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	first := len(digits) % 3
+	if first > 0 {
+		b.WriteString(digits[:first])
+	}
+	for i := first; i < len(digits); i += 3 {
+		if i > 0 {
+			b.WriteString(f.Miles)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestFormatoNumerico_Cifras(t *testing.T) {
+	tests := map[string]struct {
+		formato  FormatoNumerico
+		value    string
+		currency Currency
+		expected string
+	}{
+		"Perú":               {formato: FormatoPeru, value: "1250.50", currency: PEN, expected: "S/ 1,250.50"},
+		"España":             {formato: FormatoEspana, value: "1234567.8", currency: EUR, expected: "1.234.567,8 €"},
+		"México":             {formato: FormatoMexico, value: "999", currency: MXN, expected: "$999"},
+		"Dólares en Perú":    {formato: FormatoPeru, value: "1000000", currency: USD, expected: "US$ 1,000,000"},
+		"Negativo":           {formato: FormatoEEUU, value: "-12345.00", currency: USD, expected: "-$12,345.00"},
+		"Moneda sin símbolo": {formato: FormatoPeru, value: "5", currency: Currency{Code: "XAU"}, expected: "XAU 5"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			a, err := ParseAmount(tt.value, tt.currency)
+			if err != nil {
+				t.Fatalf("ParseAmount returned error: %v", err)
+			}
+			if got := tt.formato.Cifras(a); got != tt.expected {
+				t.Errorf("Cifras(%v) = %q; want %q", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatoPara(t *testing.T) {
+	f, ok := FormatoPara("es_es")
+	if !ok || f.Decimal != "," || !f.SimboloDespues {
+		t.Errorf("FormatoPara(es_es) = %+v, %v; want FormatoEspana", f, ok)
+	}
+	if f, ok := FormatoPara("es-PE-x-lima"); !ok || f.Miles != "," {
+		t.Errorf("FormatoPara(es-PE-x-lima) = %+v, %v; want FormatoPeru", f, ok)
+	}
+	if _, ok := FormatoPara("fr-FR"); ok {
+		t.Error("FormatoPara(fr-FR) expected false")
+	}
+}
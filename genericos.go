@@ -0,0 +1,116 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Entero interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+type Flotante interface {
+	~float32 | ~float64
+}
+
+// Decimal es cualquier tipo decimal que se imprime en notación normal o
+// científica (1250.50, 1.2505E+3).
+type Decimal interface {
+	String() string
+}
+
+// DecimalExacto lo cumplen tipos como shopspring/decimal.Decimal: el valor
+// es Coefficient × 10^Exponent. Si está disponible se usa en lugar de String.
+type DecimalExacto interface {
+	Decimal
+	Coefficient() *big.Int
+	Exponent() int32
+}
+
+// IntToWords deletrea cualquier entero sin pasar por float64, así que
+// conserva todas las cifras por encima de 2^53.
+func IntToWords[T Entero](n *NumeroALetras, value T) (string, error) {
+	return n.words(enteroDigits(value), "")
+}
+
+func IntToMoney[T Entero](n *NumeroALetras, value T, currency, cents string) (string, error) {
+	return n.money(enteroDigits(value), "", currency, cents)
+}
+
+func FloatToWords[T Flotante](n *NumeroALetras, value T, decimals int) (string, error) {
+	return n.ToWords(float64(value), decimals)
+}
+
+func FloatToMoney[T Flotante](n *NumeroALetras, value T, decimals int, currency, cents string) (string, error) {
+	return n.ToMoney(float64(value), decimals, currency, cents)
+}
+
+func DecimalToWords(n *NumeroALetras, d Decimal) (string, error) {
+	a, err := AmountFromDecimal(d, Currency{})
+	if err != nil {
+		return "", err
+	}
+	return n.words(a.signedWhole(), a.fraction)
+}
+
+func DecimalToMoney(n *NumeroALetras, d Decimal, currency Currency) (string, error) {
+	a, err := AmountFromDecimal(d, currency)
+	if err != nil {
+		return "", err
+	}
+	return a.WordsWith(n)
+}
+
+// AmountFromDecimal copia las cifras exactas de d en un Amount.
+func AmountFromDecimal(d Decimal, currency Currency) (Amount, error) {
+	var coef string
+	var exp int
+	if e, ok := d.(DecimalExacto); ok && e.Coefficient() != nil {
+		coef, exp = e.Coefficient().String(), int(e.Exponent())
+	} else {
+		m := patronDecimal.FindStringSubmatch(strings.TrimSpace(d.String()))
+		if m == nil || m[2]+m[3] == "" {
+			return Amount{}, fmt.Errorf("numeroaletras: decimal inválido %q", d.String())
+		}
+		coef, exp = m[1]+m[2]+m[3], -len(m[3])
+		if m[4] != "" {
+			e, err := strconv.Atoi(m[4])
+			if err != nil {
+				return Amount{}, fmt.Errorf("numeroaletras: decimal inválido %q", d.String())
+			}
+			exp += e
+		}
+	}
+	if exp > maxDigitos || exp < -maxDigitos {
+		return Amount{}, fmt.Errorf("numeroaletras: decimal fuera de rango %q", d.String())
+	}
+	return ParseAmount(decimalString(coef, exp), currency)
+}
+
+var patronDecimal = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+// decimalString escribe coef × 10^exp sin exponente: ("12345", -2) es
+// 123.45 y ("12", 3) es 12000.
+func decimalString(coef string, exp int) string {
+	sign := ""
+	if coef != "" && (coef[0] == '-' || coef[0] == '+') {
+		sign, coef = strings.TrimPrefix(coef[:1], "+"), coef[1:]
+	}
+	if exp >= 0 {
+		return sign + coef + strings.Repeat("0", exp)
+	}
+	if len(coef) <= -exp {
+		coef = strings.Repeat("0", -exp-len(coef)+1) + coef
+	}
+	return sign + coef[:len(coef)+exp] + "." + coef[len(coef)+exp:]
+}
+
+func enteroDigits[T Entero](value T) string {
+	if value < 0 {
+		return strconv.FormatInt(int64(value), 10)
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
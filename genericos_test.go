@@ -0,0 +1,83 @@
+package numeroaletras
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+type cantidad int64
+
+type decimalTexto string
+
+func (d decimalTexto) String() string { return string(d) }
+
+// decimalFijo imita a shopspring/decimal.Decimal.
+type decimalFijo struct {
+	coef *big.Int
+	exp  int32
+}
+
+func (d decimalFijo) String() string        { return "no usado" }
+func (d decimalFijo) Coefficient() *big.Int { return d.coef }
+func (d decimalFijo) Exponent() int32       { return d.exp }
+
+func TestIntToWords(t *testing.T) {
+	n := NewNumeroALetras()
+
+	if got, _ := IntToWords(n, uint64(math.MaxUint64)); got != "DIECIOCHO TRILLONES CUATROCIENTOS CUARENTA Y SEIS MIL SETECIENTOS CUARENTA Y CUATRO BILLONES SETENTA Y TRES MIL SETECIENTOS NUEVE MILLONES QUINIENTOS CINCUENTA Y UN MIL SEISCIENTOS QUINCE" {
+		t.Errorf("IntToWords(MaxUint64) = %v", got)
+	}
+	if got, _ := IntToWords(n, int8(-21)); got != "MENOS VEINTIUNO" {
+		t.Errorf("IntToWords(int8(-21)) = %v; want MENOS VEINTIUNO", got)
+	}
+	if got, _ := IntToWords(n, cantidad(9007199254740993)); got != "NUEVE MIL SIETE BILLONES CIENTO NOVENTA Y NUEVE MIL DOSCIENTOS CINCUENTA Y CUATRO MILLONES SETECIENTOS CUARENTA MIL NOVECIENTOS NOVENTA Y TRES" {
+		t.Errorf("IntToWords(2^53+1) = %v", got)
+	}
+	if got, _ := IntToMoney(n, uint16(1), "SOL", "CÉNTIMOS"); got != "UNO SOL" {
+		t.Errorf("IntToMoney(1) = %v; want UNO SOL", got)
+	}
+	if got, _ := FloatToWords(n, float32(2.5), 1); got != "DOS CON CINCO" {
+		t.Errorf("FloatToWords(float32(2.5)) = %v; want DOS CON CINCO", got)
+	}
+}
+
+func TestDecimalToWords(t *testing.T) {
+	n := NewNumeroALetras()
+
+	tests := map[string]struct {
+		value    Decimal
+		expected string
+	}{
+		"Texto":                {value: decimalTexto("1250.50"), expected: "MIL DOSCIENTOS CINCUENTA CON CINCUENTA"},
+		"Notación científica":  {value: decimalTexto("1.5E+3"), expected: "MIL QUINIENTOS"},
+		"Exponente negativo":   {value: decimalTexto("-25e-3"), expected: "MENOS CERO CON VEINTICINCO"},
+		"Coeficiente":          {value: decimalFijo{big.NewInt(123456789012345678), -2}, expected: "MIL DOSCIENTOS TREINTA Y CUATRO BILLONES QUINIENTOS SESENTA Y SIETE MIL OCHOCIENTOS NOVENTA MILLONES CIENTO VEINTITRÉS MIL CUATROCIENTOS CINCUENTA Y SEIS CON SETENTA Y OCHO"},
+		"Coeficiente negativo": {value: decimalFijo{big.NewInt(-7), 2}, expected: "MENOS SETECIENTOS"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := DecimalToWords(n, tt.value)
+			if err != nil {
+				t.Fatalf("DecimalToWords returned error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("DecimalToWords(%v) = %v; want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+
+	if got, _ := DecimalToMoney(n, decimalTexto("20.05"), USD); got != "VEINTE DÓLARES CON CINCO CENTAVOS" {
+		t.Errorf("DecimalToMoney = %v", got)
+	}
+	if got, _ := DecimalToMoney(n, decimalTexto("20.5"), USD); got != "VEINTE DÓLARES CON CINCUENTA CENTAVOS" {
+		t.Errorf("DecimalToMoney(20.5) = %v", got)
+	}
+	for _, bad := range []Decimal{decimalTexto("abc"), decimalTexto("."), decimalTexto("1e99")} {
+		if _, err := DecimalToWords(n, bad); err == nil {
+			t.Errorf("DecimalToWords(%v) expected error, got nil", bad)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package numeroaletras
+
+import (
+	"strings"
+)
+
+type Genero int
+
+const (
+	Masculino Genero = iota
+	Femenino
+)
+
+// cardinal deletrea num delante de un sustantivo del género indicado: con
+// apócope en masculino (UN MINUTO, VEINTIÚN DÍAS) y concordando en femenino
+// hasta los millares (UNA HORA, DOSCIENTAS UNA HECTÁREAS). MILLÓN, MILLARDO
+// y las demás escalas (todas con "LL") son masculinas, así que lo que va
+// delante de ellas no cambia.
+func (n *NumeroALetras) cardinal(num int, genero Genero) string {
+	if num == 0 {
+		return "CERO"
+	}
+	a := *n
+	a.apocope = true
+	words := strings.Fields(a.convertNumber(num))
+	if genero == Femenino {
+		for i := len(words) - 1; i >= 0; i-- {
+			w := words[i]
+			if strings.Contains(w, "LL") {
+				break
+			}
+			switch {
+			case i == len(words)-1 && w == "UN":
+				words[i] = "UNA"
+			case i == len(words)-1 && w == "VEINTIÚN":
+				words[i] = "VEINTIUNA"
+			case strings.HasSuffix(w, "IENTOS"):
+				words[i] = strings.TrimSuffix(w, "OS") + "AS"
+			}
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,33 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestCardinalGenero(t *testing.T) {
+	tests := map[string]struct {
+		number   int
+		genero   Genero
+		expected string
+	}{
+		"Un":             {number: 1, genero: Masculino, expected: "UN"},
+		"Una":            {number: 1, genero: Femenino, expected: "UNA"},
+		"Veintiún":       {number: 21, genero: Masculino, expected: "VEINTIÚN"},
+		"Veintiuna":      {number: 21, genero: Femenino, expected: "VEINTIUNA"},
+		"Treinta y una":  {number: 31, genero: Femenino, expected: "TREINTA Y UNA"},
+		"Doscientas una": {number: 201, genero: Femenino, expected: "DOSCIENTAS UNA"},
+		"Quinientas mil": {number: 500000, genero: Femenino, expected: "QUINIENTAS MIL"},
+		"Un mil":         {number: 21001, genero: Femenino, expected: "VEINTIÚN MIL UNA"},
+		"Millones":       {number: 200300000, genero: Femenino, expected: "DOSCIENTOS MILLONES TRESCIENTAS MIL"},
+	}
+
+	n := NewNumeroALetras()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if got := n.cardinal(tt.number, tt.genero); got != tt.expected {
+				t.Errorf("cardinal(%v, %v) = %v; se esperaba %v", tt.number, tt.genero, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatoIdentificador indica cómo leer un documento en voz alta. Grupos da
+// el tamaño de cada grupo y el último se repite hasta agotar las cifras; con
+// PorDigito cada cifra se lee suelta y, si no, cada grupo como un número.
+type FormatoIdentificador struct {
+	Nombre    string
+	Longitud  int
+	Grupos    []int
+	PorDigito bool
+}
+
+var (
+	FormatoDNI     = FormatoIdentificador{Nombre: "DNI", Longitud: 8, Grupos: []int{2}}
+	FormatoRUC     = FormatoIdentificador{Nombre: "RUC", Longitud: 11, Grupos: []int{2}}
+	FormatoCCI     = FormatoIdentificador{Nombre: "CCI", Longitud: 20, Grupos: []int{3, 3, 2}}
+	FormatoCelular = FormatoIdentificador{Nombre: "Celular", Longitud: 9, Grupos: []int{3}}
+	FormatoFijo    = FormatoIdentificador{Nombre: "Teléfono fijo", Longitud: 7, Grupos: []int{3, 2}}
+)
+
+// ReadDigits lee cifra por cifra, separando los grupos con comas:
+// CUATRO CINCO, SEIS SIETE.
+func (n *NumeroALetras) ReadDigits(id string, grupos ...int) (string, error) {
+	return n.ReadIdentifier(id, FormatoIdentificador{Grupos: grupos, PorDigito: true})
+}
+
+func (n *NumeroALetras) ReadIdentifier(id string, f FormatoIdentificador) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '/':
+			return -1
+		}
+		return r
+	}, id)
+	if digits == "" || !isDigits(digits) {
+		return "", fmt.Errorf("numeroaletras: identificador inválido %q", id)
+	}
+	if f.Longitud > 0 && len(digits) != f.Longitud {
+		return "", fmt.Errorf("numeroaletras: %s debe tener %d dígitos, tiene %d", f.Nombre, f.Longitud, len(digits))
+	}
+
+	c := n.sinApocope()
+	var groups []string
+	for i := 0; len(digits) > 0; i++ {
+		size := 1
+		if len(f.Grupos) > 0 {
+			size = f.Grupos[min(i, len(f.Grupos)-1)]
+		}
+		if size < 1 || size > len(digits) {
+			size = len(digits)
+		}
+		if !f.PorDigito && len(strings.TrimLeft(digits[:size], "0")) > maxDigitos {
+			return "", fmt.Errorf("numeroaletras: grupo fuera de rango %s", digits[:size])
+		}
+		groups = append(groups, c.readGroup(digits[:size], f.PorDigito))
+		digits = digits[size:]
+	}
+	return strings.Join(groups, ", "), nil
+}
+
+// readGroup lee los ceros a la izquierda como CERO y el resto como número,
+// para no perderlos: "05" es CERO CINCO.
+func (n *NumeroALetras) readGroup(group string, porDigito bool) string {
+	var words []string
+	for len(group) > 0 && (porDigito || group[0] == '0') {
+		if group[0] == '0' {
+			words = append(words, "CERO")
+		} else {
+			words = append(words, n.convertNumber(int(group[0]-'0')))
+		}
+		group = group[1:]
+	}
+	if group != "" {
+		words = append(words, n.convertDigits(group))
+	}
+	return strings.Join(words, " ")
+}
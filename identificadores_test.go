@@ -0,0 +1,100 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestReadIdentifier(t *testing.T) {
+	tests := map[string]struct {
+		id       string
+		formato  FormatoIdentificador
+		expected string
+	}{
+		"DNI en pares": {
+			id:       "98765401",
+			formato:  FormatoDNI,
+			expected: "NOVENTA Y OCHO, SETENTA Y SEIS, CINCUENTA Y CUATRO, CERO UNO",
+		},
+		"RUC": {
+			id:       "20100070970",
+			formato:  FormatoRUC,
+			expected: "VEINTE, DIEZ, CERO CERO, SETENTA, NOVENTA Y SIETE, CERO",
+		},
+		"Celular con espacios": {
+			id:       "987 654 321",
+			formato:  FormatoCelular,
+			expected: "NOVECIENTOS OCHENTA Y SIETE, SEISCIENTOS CINCUENTA Y CUATRO, TRESCIENTOS VEINTIUNO",
+		},
+		"CCI": {
+			id:       "002-193-001234567890-12",
+			formato:  FormatoCCI,
+			expected: "CERO CERO DOS, CIENTO NOVENTA Y TRES, CERO CERO, DOCE, TREINTA Y CUATRO, CINCUENTA Y SEIS, SETENTA Y OCHO, NOVENTA, DOCE",
+		},
+		"Grupo de veinte cifras": {
+			id:       "12345678901234567890",
+			formato:  FormatoIdentificador{Grupos: []int{20}},
+			expected: "DOCE TRILLONES TRESCIENTOS CUARENTA Y CINCO MIL SEISCIENTOS SETENTA Y OCHO BILLONES NOVECIENTOS UN MIL DOSCIENTOS TREINTA Y CUATRO MILLONES QUINIENTOS SESENTA Y SIETE MIL OCHOCIENTOS NOVENTA",
+		},
+		"Fijo por dígito": {
+			id:       "4567890",
+			formato:  FormatoIdentificador{Longitud: 7, Grupos: []int{3, 2}, PorDigito: true},
+			expected: "CUATRO CINCO SEIS, SIETE OCHO, NUEVE CERO",
+		},
+	}
+
+	n := NewNumeroALetras()
+	n.UseApocope(true)
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := n.ReadIdentifier(tt.id, tt.formato)
+			if err != nil {
+				t.Fatalf("ReadIdentifier(%q) retornó error: %v", tt.id, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ReadIdentifier(%q) = %v; se esperaba %v", tt.id, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReadDigits(t *testing.T) {
+	n := NewNumeroALetras()
+	tests := map[string]struct {
+		id       string
+		grupos   []int
+		expected string
+	}{
+		"Sin grupos": {id: "4501", expected: "CUATRO, CINCO, CERO, UNO"},
+		"En pares":   {id: "4567", grupos: []int{2}, expected: "CUATRO CINCO, SEIS SIETE"},
+		"Resto":      {id: "12345", grupos: []int{2}, expected: "UNO DOS, TRES CUATRO, CINCO"},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := n.ReadDigits(tt.id, tt.grupos...)
+			if err != nil {
+				t.Fatalf("ReadDigits(%q) retornó error: %v", tt.id, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ReadDigits(%q, %v) = %v; se esperaba %v", tt.id, tt.grupos, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReadIdentifier_Errores(t *testing.T) {
+	n := NewNumeroALetras()
+	if _, err := n.ReadIdentifier("1234567", FormatoDNI); err == nil {
+		t.Error("DNI de 7 dígitos expected error, got nil")
+	}
+	if _, err := n.ReadIdentifier("12A45678", FormatoDNI); err == nil {
+		t.Error("DNI con letras expected error, got nil")
+	}
+	if _, err := n.ReadIdentifier("1234567890123456789012345", FormatoIdentificador{Grupos: []int{25}}); err == nil {
+		t.Error("grupo de 25 dígitos expected error, got nil")
+	}
+	if _, err := n.ReadDigits(""); err == nil {
+		t.Error("ReadDigits vacío expected error, got nil")
+	}
+}
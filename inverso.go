@@ -0,0 +1,261 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// NormalizadorInverso hace el camino contrario de Normalizador: busca en un
+// texto libre números, importes y fechas escritos en letras y los reescribe
+// con cifras (QUINIENTOS VEINTE SOLES CON TREINTA CÉNTIMOS es S/ 520.30, el
+// veintiuno de mayo es 21/05). Ignora mayúsculas y tildes, acepta la Y entre
+// decenas y unidades en todas sus variantes y no convierte el artículo UN o
+// UNA cuando va solo.
+type NormalizadorInverso struct {
+	Simbolos map[string]string
+}
+
+func NewNormalizadorInverso() *NormalizadorInverso {
+	return &NormalizadorInverso{
+		Simbolos: maps.Clone(simbolosMoneda),
+	}
+}
+
+var valoresPalabras = map[string]uint64{
+	"cero": 0, "un": 1, "uno": 1, "una": 1, "dos": 2, "tres": 3, "cuatro": 4, "cinco": 5,
+	"seis": 6, "siete": 7, "ocho": 8, "nueve": 9, "diez": 10, "once": 11, "doce": 12,
+	"trece": 13, "catorce": 14, "quince": 15, "dieciseis": 16, "diecisiete": 17,
+	"dieciocho": 18, "diecinueve": 19, "veinte": 20, "veintiun": 21, "veintiuno": 21,
+	"veintiuna": 21, "veintidos": 22, "veintitres": 23, "veinticuatro": 24,
+	"veinticinco": 25, "veintiseis": 26, "veintisiete": 27, "veintiocho": 28,
+	"veintinueve": 29, "treinta": 30, "cuarenta": 40, "cincuenta": 50, "sesenta": 60,
+	"setenta": 70, "ochenta": 80, "noventa": 90, "cien": 100, "ciento": 100,
+	"doscientos": 200, "trescientos": 300, "cuatrocientos": 400, "quinientos": 500,
+	"seiscientos": 600, "setecientos": 700, "ochocientos": 800, "novecientos": 900,
+	"doscientas": 200, "trescientas": 300, "cuatrocientas": 400, "quinientas": 500,
+	"seiscientas": 600, "setecientas": 700, "ochocientas": 800, "novecientas": 900,
+}
+
+var escalasPalabras = map[string]uint64{
+	"millon": 1e6, "millones": 1e6,
+	"millardo": 1e9, "millardos": 1e9,
+	"billon": 1e12, "billones": 1e12,
+	"trillon": 1e18, "trillones": 1e18,
+}
+
+var monedasPalabras = map[string]string{
+	"sol": "PEN", "soles": "PEN",
+	"dolar": "USD", "dolares": "USD",
+	"euro": "EUR", "euros": "EUR",
+	"peso": "MXN", "pesos": "MXN",
+}
+
+var centimosPalabras = map[string]bool{"centimo": true, "centimos": true, "centavo": true, "centavos": true}
+
+type palabra struct {
+	texto      string
+	start, end int
+}
+
+func (z *NormalizadorInverso) Normalize(text string) string {
+	ws := palabrasDe(text)
+	var res strings.Builder
+	last := 0
+	for i := 0; i < len(ws); {
+		digits, next := z.convertir(text, ws, i)
+		if next == i {
+			i++
+			continue
+		}
+		res.WriteString(text[last:ws[i].start])
+		res.WriteString(digits)
+		last = ws[next-1].end
+		i = next
+	}
+	res.WriteString(text[last:])
+	return res.String()
+}
+
+// convertir intenta leer una fecha, un importe o un número a partir de la
+// palabra i. Devuelve i si no hay nada que convertir.
+func (z *NormalizadorInverso) convertir(text string, ws []palabra, i int) (string, int) {
+	var valor uint64
+	j := i + 1
+	if ws[i].texto == "primero" {
+		valor = 1
+	} else {
+		valor, j = leerNumero(text, ws, i)
+	}
+	if j == i {
+		return "", i
+	}
+
+	if fecha, k := leerFecha(text, ws, valor, j); k > j {
+		return fecha, k
+	}
+	if ws[i].texto == "primero" {
+		return "", i
+	}
+	// Tras millones redondos la moneda va con "de": un millón de soles.
+	m := j
+	if valor >= 1e6 && valor%1e6 == 0 && palabraEn(text, ws, m) == "de" {
+		m++
+	}
+	if code, ok := monedasPalabras[palabraEn(text, ws, m)]; ok {
+		if simbolo, ok := z.Simbolos[code]; ok {
+			cents, k := uint64(0), m+1
+			if palabraEn(text, ws, k) == "con" {
+				if c, l := leerNumero(text, ws, k+1); l > k+1 && c < 100 && centimosPalabras[palabraEn(text, ws, l)] {
+					cents, k = c, l+1
+				}
+			}
+			return fmt.Sprintf("%s %d.%02d", simbolo, valor, cents), k
+		}
+	}
+	if j == i+1 && (ws[i].texto == "un" || ws[i].texto == "una") {
+		return "", i
+	}
+	return strconv.FormatUint(valor, 10), j
+}
+
+func leerFecha(text string, ws []palabra, dia uint64, j int) (string, int) {
+	if dia < 1 || dia > 31 || palabraEn(text, ws, j) != "de" {
+		return "", j
+	}
+	mes := numeroMes(palabraEn(text, ws, j+1))
+	if mes == 0 {
+		return "", j
+	}
+	fecha, k := fmt.Sprintf("%02d/%02d", dia, mes), j+2
+	if p := palabraEn(text, ws, k); p == "de" || p == "del" {
+		l := k + 1
+		if palabraEn(text, ws, l) == "año" {
+			l++
+		}
+		if anio, m := leerNumero(text, ws, l); m > l && anio > 0 && anio < 10000 {
+			fecha, k = fmt.Sprintf("%s/%04d", fecha, anio), m
+		}
+	}
+	return fecha, k
+}
+
+func numeroMes(p string) int {
+	if p == "septiembre" {
+		p = "setiembre"
+	}
+	for m := 1; m < len(meses); m++ {
+		if quitarTildes(meses[m]) == p {
+			return m
+		}
+	}
+	return 0
+}
+
+// leerNumero consume las palabras de un número a partir de i y devuelve su
+// valor y la primera palabra que no forma parte de él.
+func leerNumero(text string, ws []palabra, i int) (uint64, int) {
+	var total, miles, grupo uint64
+	escala := uint64(math.MaxUint64)
+	j := i
+	for ; j < len(ws); j++ {
+		if j > i && !contiguas(text, ws[j-1], ws[j]) {
+			break
+		}
+		p := ws[j].texto
+		if v, ok := valoresPalabras[p]; ok {
+			switch {
+			case v == 0:
+				if j > i {
+					return total + miles + grupo, j
+				}
+				return 0, j + 1
+			case v >= 100 && grupo == 0:
+				grupo = v
+			case v < 100 && grupo%100 == 0:
+				grupo += v
+			default:
+				return total + miles + grupo, j
+			}
+			continue
+		}
+		if p == "y" && j+1 < len(ws) && contiguas(text, ws[j], ws[j+1]) {
+			decena := grupo % 100
+			v, ok := valoresPalabras[ws[j+1].texto]
+			if ok && v >= 1 && v <= 9 && decena >= 10 && decena%10 == 0 {
+				grupo += v
+				j++
+				continue
+			}
+			break
+		}
+		if p == "mil" {
+			if miles > 0 || grupo >= 1000 {
+				break
+			}
+			miles = max(grupo, 1) * 1000
+			grupo = 0
+			continue
+		}
+		if s, ok := escalasPalabras[p]; ok {
+			seg := miles + grupo
+			if seg == 0 || s >= escala || seg > (math.MaxUint64-total)/s {
+				break
+			}
+			total += seg * s
+			miles, grupo, escala = 0, 0, s
+			continue
+		}
+		break
+	}
+	return total + miles + grupo, j
+}
+
+func contiguas(text string, a, b palabra) bool {
+	return strings.TrimSpace(text[a.end:b.start]) == ""
+}
+
+func palabraEn(text string, ws []palabra, i int) string {
+	if i <= 0 || i >= len(ws) || !contiguas(text, ws[i-1], ws[i]) {
+		return ""
+	}
+	return ws[i].texto
+}
+
+// palabrasDe separa el texto en palabras en minúsculas y sin tildes,
+// recordando su posición original.
+func palabrasDe(text string) []palabra {
+	var ws []palabra
+	start := -1
+	for i, r := range text + " " {
+		switch {
+		case unicode.IsLetter(r) && start < 0:
+			start = i
+		case !unicode.IsLetter(r) && start >= 0:
+			ws = append(ws, palabra{texto: quitarTildes(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	return ws
+}
+
+func quitarTildes(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case 'á', 'Á':
+			return 'a'
+		case 'é', 'É':
+			return 'e'
+		case 'í', 'Í':
+			return 'i'
+		case 'ó', 'Ó':
+			return 'o'
+		case 'ú', 'Ú', 'ü', 'Ü':
+			return 'u'
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
@@ -0,0 +1,84 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNormalizadorInverso(t *testing.T) {
+	z := NewNormalizadorInverso()
+
+	tests := map[string]struct {
+		text     string
+		expected string
+	}{
+		"Importe con céntimos": {
+			text:     "pagó quinientos veinte soles con treinta céntimos ayer",
+			expected: "pagó S/ 520.30 ayer",
+		},
+		"Importe sin céntimos": {
+			text:     "Son MIL DOSCIENTOS CINCUENTA DÓLARES.",
+			expected: "Son US$ 1250.00.",
+		},
+		"Millones de soles": {
+			text:     "un millón de soles y dos millones de dólares con cincuenta centavos",
+			expected: "S/ 1000000.00 y US$ 2000000.50",
+		},
+		"Fecha": {
+			text:     "nos vemos el veintiuno de mayo",
+			expected: "nos vemos el 21/05",
+		},
+		"Fecha con año": {
+			text:     "Lima, primero de setiembre del año dos mil veintiséis",
+			expected: "Lima, 01/09/2026",
+		},
+		"Sin tildes y con Y antigua": {
+			text:     "tiene veintiseis años y diez y seis primos",
+			expected: "tiene 26 años y 16 primos",
+		},
+		"Millones": {
+			text:     "un millón doscientos treinta y cuatro mil quinientos sesenta y siete habitantes",
+			expected: "1234567 habitantes",
+		},
+		"Mil millones": {
+			text:     "dos mil millones",
+			expected: "2000000000",
+		},
+		"Artículo": {
+			text:     "compró un libro y una revista",
+			expected: "compró un libro y una revista",
+		},
+		"Números separados por coma": {
+			text:     "tres, cuatro y cinco",
+			expected: "3, 4 y 5",
+		},
+		"Conjunción entre números": {
+			text:     "cinco y seis",
+			expected: "5 y 6",
+		},
+		"Cero": {
+			text:     "cero soles con cinco centavos",
+			expected: "S/ 0.05",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if got := z.Normalize(tt.text); got != tt.expected {
+				t.Errorf("Normalize(%q) = %q; want %q", tt.text, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizadorInverso_IdaYVuelta(t *testing.T) {
+	n := NewNumeroALetras()
+	z := NewNormalizadorInverso()
+	for _, number := range []int{1, 15, 21, 100, 101, 999, 1001, 21000, 1000000, 2500300, 123456789} {
+		words := n.convertNumber(number)
+		if got, want := z.Normalize(words), fmt.Sprint(number); got != want {
+			t.Errorf("Normalize(%q) = %q; want %q", words, got, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package numeroaletras
+
+import (
+	"strings"
+)
+
+// Plantillas de uso frecuente en contratos. Los marcadores son {cifras}
+// (S/ 1,250.50), {letras} (como ToMoney), {factura} (como ToInvoice),
+// {moneda} (SOLES) y {codigo} (PEN).
+const (
+	PlantillaSuma     = "LA SUMA DE {cifras} ({letras})"
+	PlantillaLetras   = "{letras} ({cifras})"
+	PlantillaNotarial = "{factura} ({cifras})"
+)
+
+// Legal escribe un importe dos veces, en cifras según Formato y en letras,
+// con el orden que indique Plantilla.
+type Legal struct {
+	Numero    *NumeroALetras
+	Formato   FormatoNumerico
+	Plantilla string
+}
+
+func NewLegal(n *NumeroALetras, f FormatoNumerico) *Legal {
+	return &Legal{Numero: n, Formato: f, Plantilla: PlantillaSuma}
+}
+
+func (l *Legal) Format(a Amount) (string, error) {
+	whole := a.signedWhole()
+	cents, err := a.centimos()
+	if err != nil {
+		return "", err
+	}
+	letras, err := l.Numero.money(whole, cents, a.Currency.Name, a.Currency.Cents)
+	if err != nil {
+		return "", err
+	}
+	factura, err := l.Numero.invoice(whole, cents, a.Currency.Name)
+	if err != nil {
+		return "", err
+	}
+	return strings.NewReplacer(
+		"{cifras}", l.Formato.Cifras(a),
+		"{letras}", letras,
+		"{factura}", factura,
+		"{moneda}", a.Currency.Name,
+		"{codigo}", a.Currency.Code,
+	).Replace(l.Plantilla), nil
+}
+
+func (l *Legal) ToLegal(number float64, decimals int, currency Currency) (string, error) {
+	a, err := NewAmount(number, decimals, currency)
+	if err != nil {
+		return "", err
+	}
+	return l.Format(a)
+}
@@ -0,0 +1,59 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestLegal(t *testing.T) {
+	tests := map[string]struct {
+		formato   FormatoNumerico
+		plantilla string
+		number    float64
+		currency  Currency
+		expected  string
+	}{
+		"La suma de": {
+			formato:   FormatoPeru,
+			plantilla: PlantillaSuma,
+			number:    1250.50,
+			currency:  PEN,
+			expected:  "LA SUMA DE S/ 1,250.50 (MIL DOSCIENTOS CINCUENTA SOLES CON CINCUENTA CÉNTIMOS)",
+		},
+		"Letras primero": {
+			formato:   FormatoEspana,
+			plantilla: PlantillaLetras,
+			number:    2000,
+			currency:  EUR,
+			expected:  "DOS MIL EUROS (2.000,00 €)",
+		},
+		"Notarial": {
+			formato:   FormatoPeru,
+			plantilla: PlantillaNotarial,
+			number:    1700.05,
+			currency:  USD,
+			expected:  "MIL SETECIENTOS CON 05/100 DÓLARES (US$ 1,700.05)",
+		},
+		"Plantilla propia": {
+			formato:   FormatoMexico,
+			plantilla: "{cifras} {codigo} — {letras} M.N.",
+			number:    15,
+			currency:  MXN,
+			expected:  "$15.00 MXN — QUINCE PESOS M.N.",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			l := NewLegal(NewNumeroALetras(), tt.formato)
+			l.Plantilla = tt.plantilla
+			got, err := l.ToLegal(tt.number, 2, tt.currency)
+			if err != nil {
+				t.Fatalf("ToLegal returned error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToLegal(%v) = %q; want %q", tt.number, got, tt.expected)
+			}
+		})
+	}
+}
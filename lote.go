@@ -0,0 +1,187 @@
+package numeroaletras
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+type ModoLote int
+
+const (
+	LoteInvoice ModoLote = iota
+	LoteMoney
+	LoteWords
+)
+
+type Item struct {
+	Number   float64
+	Decimals int
+	Currency string
+	Cents    string
+}
+
+// Resultado lleva la posición del ítem en la entrada y su propio error, de
+// modo que un importe inválido no detiene el lote.
+type Resultado struct {
+	Index int
+	Texto string
+	Err   error
+}
+
+// Lote convierte muchos importes en paralelo con un único Speller, que no
+// se modifica durante la conversión y se comparte entre los workers.
+type Lote struct {
+	Speller Speller
+	Modo    ModoLote
+	Workers int
+}
+
+func NewLote(s Speller) *Lote {
+	return &Lote{Speller: s, Modo: LoteInvoice, Workers: runtime.GOMAXPROCS(0)}
+}
+
+// Convert devuelve un resultado por ítem, en el mismo orden. Si ctx se
+// cancela, los ítems pendientes quedan con ctx.Err() y ese error también se
+// devuelve.
+func (l *Lote) Convert(ctx context.Context, items []Item) ([]Resultado, error) {
+	res := make([]Resultado, len(items))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < l.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				res[i] = l.convert(i, items[i])
+			}
+		}()
+	}
+
+	next := 0
+	func() {
+		defer close(indices)
+		for ; next < len(items); next++ {
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case indices <- next:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	wg.Wait()
+
+	for i := next; i < len(items); i++ {
+		res[i] = Resultado{Index: i, Err: ctx.Err()}
+	}
+	if next < len(items) {
+		return res, ctx.Err()
+	}
+	return res, nil
+}
+
+// Stream convierte los ítems a medida que llegan por in y entrega los
+// resultados en el orden de entrada. El canal devuelto se cierra cuando in
+// se cierra y todo fue entregado, o cuando ctx se cancela; quien deje de
+// leer antes debe cancelar ctx.
+func (l *Lote) Stream(ctx context.Context, in <-chan Item) <-chan Resultado {
+	type job struct {
+		index int
+		item  Item
+	}
+	workers := l.workers()
+	out := make(chan Resultado)
+	jobs := make(chan job)
+	hechos := make(chan Resultado)
+	// ventana limita cuántos resultados pueden esperar su turno.
+	ventana := make(chan struct{}, 2*workers)
+
+	go func() {
+		defer close(jobs)
+		for i := 0; ; i++ {
+			var item Item
+			var ok bool
+			select {
+			case item, ok = <-in:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case ventana <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job{i, item}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				select {
+				case hechos <- l.convert(j.index, j.item):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(hechos)
+	}()
+
+	go func() {
+		defer close(out)
+		pendientes := make(map[int]Resultado)
+		next := 0
+		for r := range hechos {
+			pendientes[r.Index] = r
+			for p, ok := pendientes[next]; ok; p, ok = pendientes[next] {
+				delete(pendientes, next)
+				select {
+				case out <- p:
+				case <-ctx.Done():
+					return
+				}
+				<-ventana
+				next++
+			}
+		}
+	}()
+	return out
+}
+
+func (l *Lote) convert(index int, item Item) Resultado {
+	var texto string
+	var err error
+	switch l.Modo {
+	case LoteWords:
+		texto, err = l.Speller.ToWords(item.Number, item.Decimals)
+	case LoteMoney:
+		texto, err = l.Speller.ToMoney(item.Number, item.Decimals, item.Currency, item.Cents)
+	default:
+		texto, err = l.Speller.ToInvoice(item.Number, item.Decimals, item.Currency)
+	}
+	return Resultado{Index: index, Texto: texto, Err: err}
+}
+
+func (l *Lote) workers() int {
+	if l.Workers > 0 {
+		return l.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
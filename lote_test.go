@@ -0,0 +1,102 @@
+package numeroaletras
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLote_Convert(t *testing.T) {
+	n := NewNumeroALetras()
+	l := NewLote(n)
+	l.Workers = 4
+
+	items := make([]Item, 200)
+	for i := range items {
+		items[i] = Item{Number: float64(i) + 0.25, Decimals: 2, Currency: "SOLES"}
+	}
+	items[7].Number = 1e30
+
+	res, err := l.Convert(context.Background(), items)
+	if err != nil {
+		t.Fatalf("Convert returned error: %v", err)
+	}
+	for i, r := range res {
+		if r.Index != i {
+			t.Fatalf("res[%d].Index = %d", i, r.Index)
+		}
+		if i == 7 {
+			if r.Err == nil {
+				t.Errorf("res[7].Err = nil; want error")
+			}
+			continue
+		}
+		expected, _ := n.ToInvoice(items[i].Number, 2, "SOLES")
+		if r.Err != nil || r.Texto != expected {
+			t.Errorf("res[%d] = %q, %v; want %q", i, r.Texto, r.Err, expected)
+		}
+	}
+}
+
+func TestLote_ConvertCancelado(t *testing.T) {
+	l := NewLote(NewNumeroALetras())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := l.Convert(ctx, make([]Item, 10))
+	if err != context.Canceled {
+		t.Fatalf("Convert error = %v; want context.Canceled", err)
+	}
+	if len(res) != 10 || res[9].Err != context.Canceled || res[9].Index != 9 {
+		t.Errorf("res[9] = %+v; want Err context.Canceled", res[9])
+	}
+}
+
+func TestLote_Stream(t *testing.T) {
+	n := NewNumeroALetras()
+	l := NewLote(n)
+	l.Modo = LoteWords
+	l.Workers = 3
+
+	in := make(chan Item)
+	go func() {
+		defer close(in)
+		for i := 0; i < 100; i++ {
+			in <- Item{Number: float64(i * 1001)}
+		}
+	}()
+
+	i := 0
+	for r := range l.Stream(context.Background(), in) {
+		expected, _ := n.ToWords(float64(i*1001), 0)
+		if r.Index != i || r.Texto != expected || r.Err != nil {
+			t.Errorf("resultado %d = %+v; want %q", i, r, expected)
+		}
+		i++
+	}
+	if i != 100 {
+		t.Errorf("Stream entregó %d resultados; want 100", i)
+	}
+}
+
+func TestLote_StreamCancelado(t *testing.T) {
+	l := NewLote(NewNumeroALetras())
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan Item)
+	go func() {
+		for {
+			select {
+			case in <- Item{Number: 1}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out := l.Stream(ctx, in)
+	for i := 0; i < 5; i++ {
+		<-out
+	}
+	cancel()
+	for range out {
+	}
+}
@@ -0,0 +1,165 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+type Categoria int
+
+const (
+	Longitud Categoria = iota
+	Superficie
+	Volumen
+	Masa
+	Temperatura
+)
+
+// UnidadMedida es una entrada del catálogo. Factor la expresa en la unidad
+// base de su categoría (metro, metro cuadrado, metro cúbico, gramo); en
+// Temperatura no se usa porque las escalas no son proporcionales.
+type UnidadMedida struct {
+	Unidad
+	Simbolo   string
+	Categoria Categoria
+	Factor    float64
+}
+
+type prefijoSI struct {
+	simbolo string
+	nombre  string
+	tonico  string
+	factor  float64
+}
+
+// Con METRO el prefijo lleva la tilde (KILÓMETRO); con GRAMO y LITRO no.
+var prefijosSI = []prefijoSI{
+	{"da", "DECA", "DECÁ", 1e1},
+	{"k", "KILO", "KILÓ", 1e3},
+	{"h", "HECTO", "HECTÓ", 1e2},
+	{"d", "DECI", "DECÍ", 1e-1},
+	{"c", "CENTI", "CENTÍ", 1e-2},
+	{"m", "MILI", "MILÍ", 1e-3},
+	{"µ", "MICRO", "MICRÓ", 1e-6},
+}
+
+type unidadBase struct {
+	UnidadMedida
+	potencia int
+	tonico   bool
+}
+
+var unidadesBase = map[string]unidadBase{
+	"m":  {UnidadMedida{Unidad{"METRO", "METROS", Masculino}, "m", Longitud, 1}, 1, true},
+	"m2": {UnidadMedida{Unidad{"METRO CUADRADO", "METROS CUADRADOS", Masculino}, "m2", Superficie, 1}, 2, true},
+	"m3": {UnidadMedida{Unidad{"METRO CÚBICO", "METROS CÚBICOS", Masculino}, "m3", Volumen, 1}, 3, true},
+	"g":  {UnidadMedida{Unidad{"GRAMO", "GRAMOS", Masculino}, "g", Masa, 1}, 1, false},
+	"l":  {UnidadMedida{Unidad{"LITRO", "LITROS", Masculino}, "l", Volumen, 1e-3}, 1, false},
+}
+
+var unidadesSinPrefijo = map[string]UnidadMedida{
+	"ha": {Unidad{"HECTÁREA", "HECTÁREAS", Femenino}, "ha", Superficie, 1e4},
+	"a":  {Unidad{"ÁREA", "ÁREAS", Femenino}, "a", Superficie, 1e2},
+	"t":  {Unidad{"TONELADA", "TONELADAS", Femenino}, "t", Masa, 1e6},
+	"°C": {Unidad{"GRADO CELSIUS", "GRADOS CELSIUS", Masculino}, "°C", Temperatura, 0},
+	"°F": {Unidad{"GRADO FAHRENHEIT", "GRADOS FAHRENHEIT", Masculino}, "°F", Temperatura, 0},
+	"K":  {Unidad{"KELVIN", "KELVIN", Masculino}, "K", Temperatura, 0},
+}
+
+// ParseUnidadMedida reconoce abreviaturas como "m2", "m²", "kg", "ha", "ml"
+// o "°C", combinando los prefijos SI con metro, gramo y litro.
+func ParseUnidadMedida(simbolo string) (UnidadMedida, error) {
+	s := strings.TrimSpace(simbolo)
+	s = strings.NewReplacer("²", "2", "³", "3", "º", "°", "μ", "µ", "L", "l").Replace(s)
+	if strings.HasPrefix(s, "u") {
+		s = "µ" + s[1:]
+	}
+	switch s {
+	case "°c":
+		s = "°C"
+	case "°f":
+		s = "°F"
+	case "k":
+		s = "K"
+	}
+
+	if u, ok := unidadesSinPrefijo[s]; ok {
+		return u, nil
+	}
+	if u, ok := unidadesBase[s]; ok {
+		return u.UnidadMedida, nil
+	}
+	for _, p := range prefijosSI {
+		base, ok := unidadesBase[strings.TrimPrefix(s, p.simbolo)]
+		if !ok || !strings.HasPrefix(s, p.simbolo) {
+			continue
+		}
+		prefijo := p.nombre
+		if base.tonico {
+			prefijo = p.tonico
+		}
+		u := base.UnidadMedida
+		u.Simbolo = s
+		u.Singular = prefijo + base.Singular
+		u.Plural = prefijo + base.Plural
+		u.Factor = base.Factor * math.Pow(p.factor, float64(base.potencia))
+		return u, nil
+	}
+	return UnidadMedida{}, fmt.Errorf("numeroaletras: unidad desconocida %q", simbolo)
+}
+
+// ToMeasure deletrea la cantidad seguida del nombre de la unidad:
+// DOSCIENTOS CINCUENTA METROS CUADRADOS, UNA HECTÁREA.
+func (n *NumeroALetras) ToMeasure(value float64, decimals int, simbolo string) (string, error) {
+	u, err := ParseUnidadMedida(simbolo)
+	if err != nil {
+		return "", err
+	}
+	var prefix string
+	// El signo se decide después de redondear: -0.2 sin decimales es CERO.
+	value = n.redondear(value, decimals)
+	if value < 0 {
+		prefix = "MENOS "
+		value = -value
+	}
+	if value == math.Trunc(value) && value < 1e15 {
+		return prefix + n.conUnidad(int(value), u.Unidad), nil
+	}
+	tokens, err := n.WordsTokens(value, decimals)
+	if err != nil {
+		return "", err
+	}
+	words := Render(tokens)
+	// La parte entera también concuerda con la unidad: VEINTIUNA CON CINCO
+	// HECTÁREAS.
+	if u.Genero == Femenino && value < 1e15 {
+		conector := slices.IndexFunc(tokens, func(t Token) bool { return t.Kind == TokenConnector })
+		if conector >= 0 {
+			words = n.cardinal(int(value), Femenino) + " " + Render(tokens[conector:])
+		}
+	}
+	return prefix + words + " " + u.Plural, nil
+}
+
+// ToMeasureParts expresa value (en la unidad mayor) con la parte fraccionaria
+// en la unidad menor: 5.2 kg son CINCO KILOGRAMOS CON DOSCIENTOS GRAMOS.
+func (n *NumeroALetras) ToMeasureParts(value float64, mayor, menor string) (string, error) {
+	may, err := ParseUnidadMedida(mayor)
+	if err != nil {
+		return "", err
+	}
+	men, err := ParseUnidadMedida(menor)
+	if err != nil {
+		return "", err
+	}
+	if may.Categoria != men.Categoria || may.Categoria == Temperatura {
+		return "", fmt.Errorf("numeroaletras: no se puede expresar %s en %s", mayor, menor)
+	}
+	razon := math.Round(may.Factor / men.Factor)
+	if razon < 2 || math.Abs(razon-may.Factor/men.Factor) > 1e-9*razon || razon > math.MaxInt32 {
+		return "", fmt.Errorf("numeroaletras: %s no es múltiplo entero de %s", mayor, menor)
+	}
+	return n.ToQuantity(value, Magnitud{Mayor: may.Unidad, Menor: men.Unidad, Razon: int(razon), Conector: n.Conector})
+}
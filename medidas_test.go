@@ -0,0 +1,121 @@
+package numeroaletras
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseUnidadMedida(t *testing.T) {
+	tests := map[string]struct {
+		simbolo   string
+		plural    string
+		categoria Categoria
+		factor    float64
+	}{
+		"Metro cuadrado":    {simbolo: "m2", plural: "METROS CUADRADOS", categoria: Superficie, factor: 1},
+		"Superíndice":       {simbolo: "m²", plural: "METROS CUADRADOS", categoria: Superficie, factor: 1},
+		"Kilómetro":         {simbolo: "km", plural: "KILÓMETROS", categoria: Longitud, factor: 1e3},
+		"Decámetro":         {simbolo: "dam", plural: "DECÁMETROS", categoria: Longitud, factor: 10},
+		"Milímetro":         {simbolo: "mm", plural: "MILÍMETROS", categoria: Longitud, factor: 1e-3},
+		"Micrómetro":        {simbolo: "um", plural: "MICRÓMETROS", categoria: Longitud, factor: 1e-6},
+		"Centímetro cúbico": {simbolo: "cm3", plural: "CENTÍMETROS CÚBICOS", categoria: Volumen, factor: 1e-6},
+		"Kilómetro cuadr.":  {simbolo: "km2", plural: "KILÓMETROS CUADRADOS", categoria: Superficie, factor: 1e6},
+		"Kilogramo":         {simbolo: "kg", plural: "KILOGRAMOS", categoria: Masa, factor: 1e3},
+		"Mililitro":         {simbolo: "mL", plural: "MILILITROS", categoria: Volumen, factor: 1e-6},
+		"Hectárea":          {simbolo: "ha", plural: "HECTÁREAS", categoria: Superficie, factor: 1e4},
+		"Tonelada":          {simbolo: "t", plural: "TONELADAS", categoria: Masa, factor: 1e6},
+		"Celsius":           {simbolo: "ºC", plural: "GRADOS CELSIUS", categoria: Temperatura},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			u, err := ParseUnidadMedida(tt.simbolo)
+			if err != nil {
+				t.Fatalf("ParseUnidadMedida(%q) retornó error: %v", tt.simbolo, err)
+			}
+			if u.Plural != tt.plural || u.Categoria != tt.categoria || math.Abs(u.Factor-tt.factor) > 1e-12 {
+				t.Errorf("ParseUnidadMedida(%q) = %+v; se esperaba %v %v %v", tt.simbolo, u, tt.plural, tt.categoria, tt.factor)
+			}
+		})
+	}
+
+	for _, simbolo := range []string{"", "xyz", "kha"} {
+		if _, err := ParseUnidadMedida(simbolo); err == nil {
+			t.Errorf("ParseUnidadMedida(%q) expected error, got nil", simbolo)
+		}
+	}
+}
+
+func TestToMeasure(t *testing.T) {
+	tests := map[string]struct {
+		value    float64
+		decimals int
+		simbolo  string
+		expected string
+	}{
+		"Metros cuadrados":  {value: 250, simbolo: "m2", expected: "DOSCIENTOS CINCUENTA METROS CUADRADOS"},
+		"Hectáreas":         {value: 3, simbolo: "ha", expected: "TRES HECTÁREAS"},
+		"Una hectárea":      {value: 1, simbolo: "ha", expected: "UNA HECTÁREA"},
+		"Doscientas una":    {value: 201, simbolo: "t", expected: "DOSCIENTAS UNA TONELADAS"},
+		"Un kilómetro":      {value: 1, simbolo: "km", expected: "UN KILÓMETRO"},
+		"Veintiún litros":   {value: 21, simbolo: "L", expected: "VEINTIÚN LITROS"},
+		"Con decimales":     {value: 2.5, decimals: 2, simbolo: "m", expected: "DOS CON CINCUENTA METROS"},
+		"Temperatura bajo0": {value: -5, simbolo: "°C", expected: "MENOS CINCO GRADOS CELSIUS"},
+		"Cero":              {value: 0, simbolo: "m", expected: "CERO METROS"},
+		"Femenino decimal":  {value: 21.5, decimals: 1, simbolo: "ha", expected: "VEINTIUNA CON CINCO HECTÁREAS"},
+		"Doscientas con":    {value: 201.25, decimals: 2, simbolo: "t", expected: "DOSCIENTAS UNA CON VEINTICINCO TONELADAS"},
+		"Negativo a cero":   {value: -0.2, simbolo: "m", expected: "CERO METROS"},
+	}
+
+	n := NewNumeroALetras()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := n.ToMeasure(tt.value, tt.decimals, tt.simbolo)
+			if err != nil {
+				t.Fatalf("ToMeasure(%v, %q) retornó error: %v", tt.value, tt.simbolo, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToMeasure(%v, %q) = %v; se esperaba %v", tt.value, tt.simbolo, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToMeasureParts(t *testing.T) {
+	tests := map[string]struct {
+		value    float64
+		mayor    string
+		menor    string
+		expected string
+		wantErr  bool
+	}{
+		"Kilos y gramos":   {value: 5.2, mayor: "kg", menor: "g", expected: "CINCO KILOGRAMOS CON DOSCIENTOS GRAMOS"},
+		"Metros y cm":      {value: 1.01, mayor: "m", menor: "cm", expected: "UN METRO CON UN CENTÍMETRO"},
+		"Toneladas y kg":   {value: 2.5, mayor: "t", menor: "kg", expected: "DOS TONELADAS CON QUINIENTOS KILOGRAMOS"},
+		"Distinta magnit.": {value: 1, mayor: "kg", menor: "m", wantErr: true},
+		"Menor más grande": {value: 1, mayor: "g", menor: "kg", wantErr: true},
+		"Temperatura":      {value: 1, mayor: "°C", menor: "K", wantErr: true},
+	}
+
+	n := NewNumeroALetras()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			got, err := n.ToMeasureParts(tt.value, tt.mayor, tt.menor)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ToMeasureParts(%v, %q, %q) expected error, got %v", tt.value, tt.mayor, tt.menor, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToMeasureParts(%v) retornó error: %v", tt.value, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ToMeasureParts(%v, %q, %q) = %v; se esperaba %v", tt.value, tt.mayor, tt.menor, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package numeroaletras
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Normalizador reemplaza dentro de un texto libre los números, importes con
+// símbolo de moneda, porcentajes, fechas dd/mm/aaaa o dd.mm.aaaa y ordinales
+// (1.º, 3.ª) por su forma en letras, sin tocar el resto. Los números se leen
+// con coma de millares y punto decimal (1,250.50), como en Perú.
+type Normalizador struct {
+	Numero     *NumeroALetras
+	Simbolos   map[string]Currency
+	Fecha      EstiloFecha
+	Minusculas bool
+}
+
+func NewNormalizador(n *NumeroALetras) *Normalizador {
+	return &Normalizador{
+		Numero: n,
+		Simbolos: map[string]Currency{
+			"S/":  PEN,
+			"S/.": PEN,
+			"US$": USD,
+			"$":   USD,
+			"€":   EUR,
+		},
+		Fecha: FechaCorta,
+	}
+}
+
+const patronCifras = `\d{1,3}(?:,\d{3})+(?:\.\d+)?|\d+(?:\.\d+)?`
+
+// El orden de las alternativas importa: la primera que coincide gana.
+var patronNormalizar = regexp.MustCompile(
+	`(S/\.?|US\$|\$|€) ?(` + patronCifras + `)` +
+		`|(` + patronCifras + `) ?(€)` +
+		`|(\d{1,2})([/.])(\d{1,2})([/.])(\d{4})` +
+		`|(` + patronCifras + `) ?%` +
+		`|(\d{1,6})\.?([ºª])` +
+		`|(` + patronCifras + `)`)
+
+func (z *Normalizador) Normalize(text string) string {
+	var res strings.Builder
+	last := 0
+	for _, m := range patronNormalizar.FindAllStringSubmatchIndex(text, -1) {
+		start, end := m[0], m[1]
+		if !z.aislado(text, start, end) {
+			continue
+		}
+		group := func(i int) string {
+			if m[2*i] < 0 {
+				return ""
+			}
+			return text[m[2*i]:m[2*i+1]]
+		}
+
+		var words string
+		var ok bool
+		switch {
+		case m[2] >= 0:
+			words, ok = z.importe(group(2), group(1))
+		case m[6] >= 0:
+			words, ok = z.importe(group(3), group(4))
+		case m[10] >= 0:
+			// 1/1.2026 no es una fecha; se deja como está.
+			if group(6) == group(8) {
+				words, ok = z.fecha(group(5), group(7), group(9))
+			}
+		case m[20] >= 0:
+			words, ok = z.numero(group(10))
+			words += " POR CIENTO"
+		case m[22] >= 0:
+			words, ok = z.ordinal(group(11), group(12), text[end:])
+		default:
+			words, ok = z.numero(group(13))
+		}
+		if !ok {
+			continue
+		}
+		if z.Minusculas {
+			words = strings.ToLower(words)
+		}
+		res.WriteString(text[last:start])
+		res.WriteString(words)
+		last = end
+	}
+	res.WriteString(text[last:])
+	return res.String()
+}
+
+// aislado descarta cifras pegadas a letras o a otras cifras, como en A4 o
+// COVID19.
+func (z *Normalizador) aislado(text string, start, end int) bool {
+	if r, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		if unicode.IsDigit(rune(text[start])) {
+			return false
+		}
+	}
+	if r, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		return false
+	}
+	// Tampoco se leen a medias secuencias como 1.250.000 o 10.20.30.
+	if end+1 < len(text) && text[end] == '.' && isDigit(text[end+1]) {
+		return false
+	}
+	if start > 1 && text[start-1] == '.' && isDigit(text[start-2]) && isDigit(text[start]) {
+		return false
+	}
+	return true
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+func (z *Normalizador) numero(cifras string) (string, bool) {
+	whole, fraction := splitCifras(cifras)
+	words, err := z.Numero.words(whole, fraction)
+	return words, err == nil
+}
+
+func (z *Normalizador) importe(cifras, simbolo string) (string, bool) {
+	c, ok := z.Simbolos[simbolo]
+	if !ok {
+		return "", false
+	}
+	// Con más decimales que la moneda (S/ 12.345) el importe no es claro y
+	// se deja como está.
+	a, err := ParseAmount(strings.ReplaceAll(cifras, ",", ""), c)
+	if err != nil {
+		return "", false
+	}
+	words, err := a.WordsWith(z.Numero)
+	return words, err == nil
+}
+
+// ordinal usa la forma apocopada delante de un sustantivo (3.º piso, TERCER
+// piso), pero no en fechas como 1.º de mayo.
+func (z *Normalizador) ordinal(cifras, marca, resto string) (string, bool) {
+	n := *z.Numero
+	genero := Femenino
+	if marca == "º" {
+		genero = Masculino
+		if palabras := strings.Fields(resto); len(palabras) > 0 && strings.HasPrefix(resto, " ") {
+			r, _ := utf8.DecodeRuneInString(palabras[0])
+			siguiente := strings.ToLower(palabras[0])
+			n.apocope = unicode.IsLetter(r) && siguiente != "de" && siguiente != "del"
+		}
+	}
+	words, err := n.ToOrdinal(mustAtoi(cifras), genero)
+	return words, err == nil
+}
+
+func (z *Normalizador) fecha(dia, mes, anio string) (string, bool) {
+	d, m, y := mustAtoi(dia), time.Month(mustAtoi(mes)), mustAtoi(anio)
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if t.Day() != d || t.Month() != m {
+		return "", false
+	}
+	words, err := z.Numero.ToDate(t, z.Fecha)
+	return words, err == nil
+}
+
+func splitCifras(cifras string) (string, string) {
+	whole, fraction, _ := strings.Cut(strings.ReplaceAll(cifras, ",", ""), ".")
+	return whole, fraction
+}
@@ -0,0 +1,85 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	z := NewNormalizador(NewNumeroALetras())
+
+	tests := map[string]struct {
+		text     string
+		expected string
+	}{
+		"Importe, fecha y número": {
+			text:     "Pagará S/ 1,250.50 el 15/10/2026 (3 cuotas)",
+			expected: "Pagará MIL DOSCIENTOS CINCUENTA SOLES CON CINCUENTA CÉNTIMOS el QUINCE DE OCTUBRE DE DOS MIL VEINTISÉIS (TRES cuotas)",
+		},
+		"Dólares": {
+			text:     "Costó US$ 20 y luego $5.5.",
+			expected: "Costó VEINTE DÓLARES y luego CINCO DÓLARES CON CINCUENTA CENTAVOS.",
+		},
+		"Más decimales que la moneda": {
+			text:     "Son S/ 12.345 y Debe € 99.999",
+			expected: "Son S/ 12.345 y Debe € 99.999",
+		},
+		"Euros después del número": {
+			text:     "Son 30 € en total",
+			expected: "Son TREINTA EUROS en total",
+		},
+		"Porcentaje": {
+			text:     "Un descuento del 15% o del 2.5 %",
+			expected: "Un descuento del QUINCE POR CIENTO o del DOS CON CINCO POR CIENTO",
+		},
+		"Ordinales": {
+			text:     "Vive en el 3.º piso, es la 1ª vez",
+			expected: "Vive en el TERCER piso, es la PRIMERA vez",
+		},
+		"Ordinal en fecha": {
+			text:     "Desde el 1.º de mayo",
+			expected: "Desde el PRIMERO de mayo",
+		},
+		"Grados no son ordinales": {
+			text:     "Hace 25° de calor",
+			expected: "Hace VEINTICINCO° de calor",
+		},
+		"Fecha con puntos": {
+			text:     "Vence el 1.1.2026",
+			expected: "Vence el UNO DE ENERO DE DOS MIL VEINTISÉIS",
+		},
+		"Cifras con varios puntos": {
+			text:     "Código 1.250.000 y versión 10.20.30",
+			expected: "Código 1.250.000 y versión 10.20.30",
+		},
+		"Cifras pegadas a letras": {
+			text:     "Hoja A4 y COVID19 en 2 copias",
+			expected: "Hoja A4 y COVID19 en DOS copias",
+		},
+		"Fecha inválida": {
+			text:     "el 31/02/2026",
+			expected: "el 31/02/2026",
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if got := z.Normalize(tt.text); got != tt.expected {
+				t.Errorf("Normalize(%q) = %q; want %q", tt.text, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalize_Minusculas(t *testing.T) {
+	n := NewNumeroALetras()
+	n.UseApocope(true)
+	z := NewNormalizador(n)
+	z.Minusculas = true
+
+	text := "Tiene 21 años y debe S/ 100"
+	expected := "Tiene veintiún años y debe cien soles"
+	if got := z.Normalize(text); got != expected {
+		t.Errorf("Normalize(%q) = %q; want %q", text, got, expected)
+	}
+}
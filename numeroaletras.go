@@ -5,26 +5,21 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 type NumeroALetras struct {
-	unidades           []string
-	decenas            []string
-	centenas           []string
-	acentosExcepciones map[string]string
-	Conector           string
-	apocope            bool
+	tablas   *tablasEspanol
+	Conector string
+	apocope  bool
+	variante Variante
+	escala   Escala
+	grupos   *[2][1000]string
 }
 
+// NewNumeroALetras devuelve el conversor del locale "es".
 func NewNumeroALetras() *NumeroALetras {
-	return &NumeroALetras{
-		unidades:           []string{"", "UNO ", "DOS ", "TRES ", "CUATRO ", "CINCO ", "SEIS ", "SIETE ", "OCHO ", "NUEVE ", "DIEZ ", "ONCE ", "DOCE ", "TRECE ", "CATORCE ", "QUINCE ", "DIECISÉIS ", "DIECISIETE ", "DIECIOCHO ", "DIECINUEVE ", "VEINTE "},
-		decenas:            []string{"VEINTI", "TREINTA ", "CUARENTA ", "CINCUENTA ", "SESENTA ", "SETENTA ", "OCHENTA ", "NOVENTA ", "CIEN "},
-		centenas:           []string{"CIENTO ", "DOSCIENTOS ", "TRESCIENTOS ", "CUATROCIENTOS ", "QUINIENTOS ", "SEISCIENTOS ", "SETECIENTOS ", "OCHOCIENTOS ", "NOVECIENTOS "},
-		acentosExcepciones: map[string]string{"VEINTIDOS": "VEINTIDÓS ", "VEINTITRES": "VEINTITRÉS ", "VEINTISEIS": "VEINTISÉIS "},
-		Conector:           "CON",
-		apocope:            false,
-	}
+	return regionesEspanol["es"].New()
 }
 
 func (n *NumeroALetras) redondear(numero float64, decimales int) float64 {
@@ -34,137 +29,244 @@ func (n *NumeroALetras) redondear(numero float64, decimales int) float64 {
 
 func (n *NumeroALetras) ToWords(number float64, decimals int) (string, error) {
 	number = n.redondear(number, decimals)
-	numberStr := fmt.Sprintf("%.*f", decimals, number)
-	parts := strings.Split(numberStr, ".")
+	whole, fraction := splitNumber(number, decimals)
+	return n.words(whole, fraction)
+}
+
+func (n *NumeroALetras) ToMoney(number float64, decimals int, currency, cents string) (string, error) {
+	whole, fraction := splitNumber(number, decimals)
+	return n.money(whole, fraction, currency, cents)
+}
+
+func (n *NumeroALetras) ToString(number float64, decimals int, wholeStr, decimalStr string) (string, error) {
+	return n.ToMoney(number, decimals, wholeStr, decimalStr)
+}
+
+func (n *NumeroALetras) ToInvoice(number float64, decimals int, currency string) (string, error) {
+	whole, fraction := splitNumber(number, decimals)
+	return n.invoice(whole, fraction, currency)
+}
 
-	whole, err := n.wholeNumber(parts[0])
+func (n *NumeroALetras) words(wholeDigits, fraction string) (string, error) {
+	tokens, err := n.wordsTokens(wholeDigits, fraction)
 	if err != nil {
 		return "", err
 	}
+	return Render(tokens), nil
+}
 
-	var decimal string
-	if len(parts) > 1 && parts[1] != "" {
-		decimalInt, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return "", err
-		}
-		decimal = n.convertNumber(decimalInt)
+func (n *NumeroALetras) money(wholeDigits, fraction, currency, cents string) (string, error) {
+	tokens, err := n.moneyTokens(wholeDigits, fraction, currency, cents)
+	if err != nil {
+		return "", err
 	}
-
-	return n.concat([]string{whole, decimal}), nil
+	return Render(tokens), nil
 }
 
-func (n *NumeroALetras) ToMoney(number float64, decimals int, currency, cents string) (string, error) {
-	numberStr := fmt.Sprintf("%.*f", decimals, number)
-	parts := strings.Split(numberStr, ".")
-
-	whole, err := n.wholeNumber(parts[0])
+func (n *NumeroALetras) invoice(wholeDigits, fraction, currency string) (string, error) {
+	tokens, err := n.invoiceTokens(wholeDigits, fraction, currency)
 	if err != nil {
 		return "", err
 	}
-	whole = strings.TrimSpace(whole) + " " + strings.ToUpper(currency)
+	return Render(tokens), nil
+}
+
+func (n *NumeroALetras) UseApocope(value bool) {
+	n.apocope = value
+}
 
-	var decimal string
-	if len(parts) > 1 && parts[1] != "" && !isZero(parts[1]) {
-		decimalInt, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return "", err
+// escribirEntero deletrea la parte entera. Un "-" inicial produce el token
+// de signo, salvo que todo el número sea cero.
+func (n *NumeroALetras) escribirEntero(s *salida, number, fraction []byte) error {
+	negative := len(number) > 0 && number[0] == '-'
+	if negative {
+		number = number[1:]
+	}
+	if len(number) == 0 || !isDigits(number) {
+		if _, err := strconv.Atoi(string(number)); err != nil {
+			return err
 		}
-		decimal = n.convertNumber(decimalInt) + " " + strings.ToUpper(cents)
+		return fmt.Errorf("numeroaletras: número fuera de rango %s", string(number))
 	}
-
-	return n.concat([]string{whole, decimal}), nil
+	digits := trimCeros(number)
+	if len(digits) > maxDigitos {
+		return fmt.Errorf("numeroaletras: número fuera de rango %s", string(number))
+	}
+	if negative && (len(digits) > 0 || !isZero(fraction)) {
+		s.add(TokenSign, "MENOS", signo)
+	}
+	if len(digits) == 0 {
+		s.add(TokenNumeral, "CERO", cero)
+		return nil
+	}
+	n.escribirCifras(s, digits)
+	return nil
 }
 
-func (n *NumeroALetras) ToString(number float64, decimals int, wholeStr, decimalStr string) (string, error) {
-	return n.ToMoney(number, decimals, wholeStr, decimalStr)
+// escribirDecimales deletrea la parte decimal como un número entero, sin
+// nada si es cero.
+func (n *NumeroALetras) escribirDecimales(s *salida, fraction []byte) (bool, error) {
+	if isZero(fraction) {
+		return false, nil
+	}
+	if !isDigits(fraction) || len(trimCeros(fraction)) > maxDigitos {
+		return false, fmt.Errorf("numeroaletras: decimales inválidos %s", string(fraction))
+	}
+	s.add(TokenConnector, strings.ToUpper(n.Conector), nil)
+	n.escribirCifras(s, fraction)
+	return true, nil
 }
 
-func (n *NumeroALetras) ToInvoice(number float64, decimals int, currency string) (string, error) {
-	numberStr := fmt.Sprintf("%.*f", decimals, number)
-	parts := strings.Split(numberStr, ".")
+var (
+	escalas = [][2]string{{"", ""}, {"MILLÓN", "MILLONES"}, {"BILLÓN", "BILLONES"}, {"TRILLÓN", "TRILLONES"}}
+	signo   = []byte("-")
+	cero    = []byte("0")
+)
 
-	whole, err := n.wholeNumber(parts[0])
-	if err != nil {
-		return "", err
+const maxDigitos = 6 * 4
+
+func (n *NumeroALetras) convertNumber(num int) string {
+	if num < 0 {
+		return "Número fuera de rango"
 	}
+	return n.convertDigits(strconv.Itoa(num))
+}
 
-	decimal := "00/100 "
-	if len(parts) > 1 && parts[1] != "" {
-		d := mustAtoi(parts[1])
-		decimal = fmt.Sprintf("%02d/100 ", d)
+func (n *NumeroALetras) convertDigits(digits string) string {
+	if len(strings.TrimLeft(digits, "0")) > maxDigitos {
+		return "Número fuera de rango"
 	}
+	s := salida{texto: true}
+	n.escribirCifras(&s, []byte(digits))
+	return string(s.buf)
+}
 
-	return fmt.Sprintf("%s %s", n.concat([]string{whole, decimal}), strings.ToUpper(currency)), nil
+func (n *NumeroALetras) digitTokens(digits string) []Token {
+	var s salida
+	n.escribirCifras(&s, []byte(digits))
+	return s.tokens
 }
 
-func (n *NumeroALetras) UseApocope(value bool) {
-	if len(n.unidades) > 1 {
-		n.unidades[1] = map[bool]string{true: "UN ", false: "UNO "}[value]
-		n.apocope = value
+// escribirCifras deletrea en escala larga: bloques de seis cifras, cada uno
+// con su nombre (MILLONES, BILLONES...). Las cifras ya vienen validadas.
+func (n *NumeroALetras) escribirCifras(s *salida, digits []byte) {
+	digits = trimCeros(digits)
+	if n.escala == EscalaCorta {
+		n.escribirEscalaCorta(s, digits)
+		return
+	}
+	blocks := (len(digits) + 5) / 6
+	for i := 0; i < blocks; i++ {
+		escala := blocks - 1 - i
+		end := len(digits) - escala*6
+		block := digits[max(end-6, 0):end]
+		if isZero(block) {
+			continue
+		}
+		n.escribirBloque(s, block, escala)
 	}
 }
 
-func (n *NumeroALetras) wholeNumber(number string) (string, error) {
-	if number == "0" {
-		return "CERO ", nil
+func (n *NumeroALetras) escribirBloque(s *salida, block []byte, escala int) {
+	thou, hund := partirMiles(block)
+	if escala == 0 {
+		n.escribirMiles(s, thou, hund, n.apocope)
+		return
 	}
-	num, err := strconv.Atoi(number)
-	if err != nil {
-		return "", err
+	if escala == 1 && n.variante == VarianteMillardo && !isZero(thou) {
+		n.escribirEscala(s, thou, "MILLARDO", "MILLARDOS")
+		if !isZero(hund) {
+			n.escribirEscala(s, hund, escalas[1][0], escalas[1][1])
+		}
+		return
 	}
-	return n.convertNumber(num), nil
+	if isZero(thou) {
+		n.escribirEscala(s, hund, escalas[escala][0], escalas[escala][1])
+		return
+	}
+	n.escribirMiles(s, thou, hund, true)
+	s.add(TokenScale, escalas[escala][1], nil)
 }
 
-func (n *NumeroALetras) concat(parts []string) string {
-	var clean []string
-	for _, part := range parts {
-		if p := strings.TrimSpace(part); p != "" {
-			clean = append(clean, p)
-		}
+// escribirEscala deletrea un grupo de tres cifras seguido del nombre de
+// escala, en singular solo para UN MILLÓN, UN BILLÓN...
+func (n *NumeroALetras) escribirEscala(s *salida, group []byte, singular, plural string) {
+	name := plural
+	if valorGrupo(group) == 1 {
+		name = singular
 	}
-	var results = strings.Join(clean, fmt.Sprintf(" %s ", strings.ToUpper(n.Conector)))
-	return strings.ReplaceAll(results, "  ", " ")
+	n.escribirGrupo(s, group, true)
+	s.add(TokenScale, name, nil)
 }
 
-func (n *NumeroALetras) convertNumber(num int) string {
-	if num < 0 || num > 999999999 {
-		return "Número fuera de rango"
+func (n *NumeroALetras) convertThousands(block string, apocope bool) string {
+	s := salida{texto: true}
+	thou, hund := partirMiles([]byte(block))
+	n.escribirMiles(&s, thou, hund, apocope)
+	return string(s.buf)
+}
+
+// escribirMiles deletrea de 1 a 999999. Delante de MIL y de los nombres de
+// escala el uno siempre se apocopa (VEINTIÚN MIL, CIENTO UN MILLONES).
+func (n *NumeroALetras) escribirMiles(s *salida, thou, hund []byte, apocope bool) {
+	switch v := valorGrupo(thou); {
+	case v == 1:
+		s.add(TokenScale, "MIL", trimCeros(thou))
+	case v > 0:
+		n.escribirGrupo(s, thou, true)
+		s.add(TokenScale, "MIL", nil)
 	}
+	if !isZero(hund) {
+		n.escribirGrupo(s, hund, apocope)
+	}
+}
 
-	s := fmt.Sprintf("%09d", num)
-	mill, _ := strconv.Atoi(s[0:3])
-	thou, _ := strconv.Atoi(s[3:6])
-	hund, _ := strconv.Atoi(s[6:9])
+func (n *NumeroALetras) escribirGrupo(s *salida, group []byte, apocope bool) {
+	s.add(TokenNumeral, n.grupo(valorGrupo(group), apocope), trimCeros(group))
+}
 
-	var res strings.Builder
-	if mill > 0 {
-		if mill == 1 {
-			res.WriteString("UN MILLÓN ")
-		} else {
-			res.WriteString(fmt.Sprintf("%s MILLONES ", n.convertGroup(s[0:3])))
-		}
+func (n *NumeroALetras) grupo(v int, apocope bool) string {
+	if apocope {
+		return n.grupos[1][v]
 	}
-	if thou > 0 {
-		if thou == 1 {
-			res.WriteString("MIL ")
-		} else {
-			res.WriteString(fmt.Sprintf("%s MIL ", n.convertGroup(s[3:6])))
+	return n.grupos[0][v]
+}
+
+// gruposPorVariante guarda las grafías de 0 a 999, sin y con apócope, que
+// se calculan una sola vez por variante.
+var gruposPorVariante [3]struct {
+	once  sync.Once
+	tabla *[2][1000]string
+}
+
+func (n *NumeroALetras) tablaGrupos() *[2][1000]string {
+	build := func() *[2][1000]string {
+		var t [2][1000]string
+		for i := range t[0] {
+			group := fmt.Sprintf("%03d", i)
+			t[0][i] = strings.TrimSpace(n.convertGroup(group, false))
+			t[1][i] = strings.TrimSpace(n.convertGroup(group, true))
 		}
+		return &t
 	}
-	if hund > 0 {
-		if hund == 1 {
-			res.WriteString(map[bool]string{true: "UN ", false: "UNO "}[n.apocope])
-		} else {
-			res.WriteString(fmt.Sprintf("%s ", n.convertGroup(s[6:9])))
-		}
+	if n.variante < 0 || int(n.variante) >= len(gruposPorVariante) {
+		return build()
 	}
-	return strings.TrimSpace(res.String())
+	g := &gruposPorVariante[n.variante]
+	g.once.Do(func() { g.tabla = build() })
+	return g.tabla
 }
 
-func (n *NumeroALetras) convertGroup(group string) string {
+func (n *NumeroALetras) convertGroup(group string, apocope bool) string {
+	text, _ := n.explainGroup(group, apocope)
+	return text
+}
+
+// explainGroup deletrea un grupo de tres cifras y devuelve también las reglas
+// que intervinieron, para Explain.
+func (n *NumeroALetras) explainGroup(group string, apocope bool) (string, []Regla) {
 	if group == "100" {
-		return "CIEN "
+		return "CIEN ", []Regla{ReglaCien}
 	}
 
 	h := int(group[0] - '0')
@@ -172,32 +274,102 @@ func (n *NumeroALetras) convertGroup(group string) string {
 	u := int(group[2] - '0')
 	lastTwo := t*10 + u
 
+	var aplicadas []Regla
+	unidades := n.tablas.unidades
+	if apocope {
+		unidades = append([]string{"", "UN "}, n.tablas.unidades[2:]...)
+		if u == 1 && t != 1 {
+			aplicadas = append(aplicadas, ReglaApocope)
+		}
+	}
+
 	var res strings.Builder
 	if h > 0 {
-		res.WriteString(n.centenas[h-1])
+		res.WriteString(n.tablas.centenas[h-1])
+		if h == 1 {
+			aplicadas = append(aplicadas, ReglaCiento)
+		}
 	}
 	var unit string
 	if lastTwo <= 20 {
-		unit = n.unidades[lastTwo]
+		unit = unidades[lastTwo]
 	} else {
-		if t-2 >= 0 && t-2 < len(n.decenas) {
+		if t-2 >= 0 && t-2 < len(n.tablas.decenas) {
 			if lastTwo > 30 && u != 0 {
-				unit = fmt.Sprintf("%sY %s", n.decenas[t-2], n.unidades[u])
+				unit = n.tablas.decenas[t-2] + "Y " + unidades[u]
 			} else {
-				unit = fmt.Sprintf("%s%s", n.decenas[t-2], n.unidades[u])
+				unit = n.tablas.decenas[t-2] + unidades[u]
 			}
 		}
 	}
 	unit = strings.TrimSpace(unit)
-	if val, ok := n.acentosExcepciones[strings.ToUpper(unit)]; ok {
+	// La Y puede venir de las tablas: VEINTE Y UNO y DIEZ Y SEIS en la
+	// variante antigua.
+	if strings.Contains(unit, " Y ") {
+		aplicadas = append(aplicadas, ReglaConjuncion)
+	}
+	if val, ok := n.tablas.acentos[strings.ToUpper(unit)]; ok {
 		unit = val
+		aplicadas = append(aplicadas, ReglaAcento)
 	}
 	res.WriteString(unit)
-	return res.String()
+	return res.String(), aplicadas
+}
+
+// splitSinSigno separa number como splitNumber para los idiomas que no
+// deletrean negativos. El signo se decide sobre el valor redondeado completo:
+// -0.001 con dos decimales es cero, pero -0.50 es negativo aunque su parte
+// entera sea cero.
+func splitSinSigno(number float64, decimals int) (string, string, error) {
+	whole, fraction := splitNumber(number, decimals)
+	digits, negativo := strings.CutPrefix(whole, "-")
+	if !negativo {
+		return whole, fraction, nil
+	}
+	if isZero(digits) && isZero(fraction) {
+		return digits, fraction, nil
+	}
+	return "", "", fmt.Errorf("numeroaletras: número negativo %s", strings.TrimSuffix(whole+"."+fraction, "."))
+}
+
+func splitNumber(number float64, decimals int) (string, string) {
+	parts := strings.Split(fmt.Sprintf("%.*f", decimals, number), ".")
+	if len(parts) > 1 {
+		return parts[0], parts[1]
+	}
+	return parts[0], ""
 }
 
-func isZero(s string) bool {
-	return strings.TrimLeft(s, "0") == ""
+func isZero[T string | []byte](s T) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '0' {
+			return false
+		}
+	}
+	return true
+}
+
+func trimCeros(b []byte) []byte {
+	for len(b) > 0 && b[0] == '0' {
+		b = b[1:]
+	}
+	return b
+}
+
+// partirMiles separa las tres últimas cifras de un bloque de hasta seis.
+func partirMiles(block []byte) (thou, hund []byte) {
+	if len(block) <= 3 {
+		return nil, block
+	}
+	return block[:len(block)-3], block[len(block)-3:]
+}
+
+func valorGrupo(group []byte) int {
+	v := 0
+	for _, c := range group {
+		v = v*10 + int(c-'0')
+	}
+	return v
 }
 
 func mustAtoi(s string) int {
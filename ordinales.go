@@ -0,0 +1,64 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	ordinalesUnidades   = []string{"", "PRIMERO", "SEGUNDO", "TERCERO", "CUARTO", "QUINTO", "SEXTO", "SÉPTIMO", "OCTAVO", "NOVENO"}
+	ordinalesDecenas    = []string{"", "DÉCIMO", "VIGÉSIMO", "TRIGÉSIMO", "CUADRAGÉSIMO", "QUINCUAGÉSIMO", "SEXAGÉSIMO", "SEPTUAGÉSIMO", "OCTOGÉSIMO", "NONAGÉSIMO"}
+	ordinalesCentenas   = []string{"", "CENTÉSIMO", "DUCENTÉSIMO", "TRICENTÉSIMO", "CUADRINGENTÉSIMO", "QUINGENTÉSIMO", "SEXCENTÉSIMO", "SEPTINGENTÉSIMO", "OCTINGENTÉSIMO", "NONINGENTÉSIMO"}
+	ordinalesEspeciales = map[int]string{11: "UNDÉCIMO", 12: "DUODÉCIMO", 13: "DECIMOTERCERO", 14: "DECIMOCUARTO", 15: "DECIMOQUINTO", 16: "DECIMOSEXTO", 17: "DECIMOSÉPTIMO", 18: "DECIMOCTAVO", 19: "DECIMONOVENO"}
+)
+
+// ToOrdinal deletrea ordinales de 1 a 999999. Con apócope el masculino
+// pierde la -O final ante sustantivo (PRIMER, VIGÉSIMO TERCER).
+func (n *NumeroALetras) ToOrdinal(number int, genero Genero) (string, error) {
+	if number < 1 || number > 999999 {
+		return "", fmt.Errorf("numeroaletras: ordinal fuera de rango %d", number)
+	}
+
+	var parts []string
+	if thou := number / 1000; thou > 0 {
+		if thou == 1 {
+			parts = append(parts, "MILÉSIMO")
+		} else {
+			prefix := n.sinApocope().convertThousands(fmt.Sprintf("%06d", thou), true)
+			prefix = strings.ReplaceAll(strings.Join(strings.Fields(prefix), ""), "Ú", "U")
+			parts = append(parts, prefix+"MILÉSIMO")
+		}
+	}
+	if h := number / 100 % 10; h > 0 {
+		parts = append(parts, ordinalesCentenas[h])
+	}
+	if rest := number % 100; rest > 0 {
+		if especial, ok := ordinalesEspeciales[rest]; ok {
+			parts = append(parts, especial)
+		} else {
+			if t := rest / 10; t > 0 {
+				parts = append(parts, ordinalesDecenas[t])
+			}
+			if u := rest % 10; u > 0 {
+				parts = append(parts, ordinalesUnidades[u])
+			}
+		}
+	}
+
+	last := len(parts) - 1
+	switch {
+	case genero == Femenino:
+		for i, part := range parts {
+			parts[i] = strings.TrimSuffix(part, "O") + "A"
+		}
+	case n.apocope && (strings.HasSuffix(parts[last], "PRIMERO") || strings.HasSuffix(parts[last], "TERCERO")):
+		parts[last] = strings.TrimSuffix(parts[last], "O")
+	}
+	return strings.Join(parts, " "), nil
+}
+
+func (n *NumeroALetras) sinApocope() *NumeroALetras {
+	c := *n
+	c.apocope = false
+	return &c
+}
@@ -0,0 +1,52 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestToOrdinal(t *testing.T) {
+	tests := map[string]struct {
+		number   int
+		genero   Genero
+		apocope  bool
+		expected string
+	}{
+		"Primero":              {number: 1, expected: "PRIMERO"},
+		"Primer":               {number: 1, apocope: true, expected: "PRIMER"},
+		"Primera":              {number: 1, genero: Femenino, apocope: true, expected: "PRIMERA"},
+		"Décimo":               {number: 10, expected: "DÉCIMO"},
+		"Undécimo":             {number: 11, expected: "UNDÉCIMO"},
+		"Decimotercero":        {number: 13, expected: "DECIMOTERCERO"},
+		"Decimotercer":         {number: 13, apocope: true, expected: "DECIMOTERCER"},
+		"Vigésimo primero":     {number: 21, expected: "VIGÉSIMO PRIMERO"},
+		"Vigésima tercera":     {number: 23, genero: Femenino, expected: "VIGÉSIMA TERCERA"},
+		"Centésimo":            {number: 100, expected: "CENTÉSIMO"},
+		"Quingentésimo":        {number: 584, expected: "QUINGENTÉSIMO OCTOGÉSIMO CUARTO"},
+		"Milésimo":             {number: 1000, expected: "MILÉSIMO"},
+		"Dosmilésimo":          {number: 2026, expected: "DOSMILÉSIMO VIGÉSIMO SEXTO"},
+		"Veintiunmilésimo":     {number: 21000, expected: "VEINTIUNMILÉSIMO"},
+		"Dosmilésima femenina": {number: 2000, genero: Femenino, expected: "DOSMILÉSIMA"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			n := NewNumeroALetras()
+			n.UseApocope(tt.apocope)
+			words, err := n.ToOrdinal(tt.number, tt.genero)
+			if err != nil {
+				t.Fatalf("ToOrdinal(%v) retornó error: %v", tt.number, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToOrdinal(%v) = %v; se esperaba %v", tt.number, words, tt.expected)
+			}
+		})
+	}
+
+	n := NewNumeroALetras()
+	for _, number := range []int{0, -1, 1000000} {
+		if _, err := n.ToOrdinal(number, Masculino); err == nil {
+			t.Errorf("ToOrdinal(%v) expected error, got nil", number)
+		}
+	}
+}
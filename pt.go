@@ -0,0 +1,246 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type PortugueseVariant int
+
+const (
+	PortugueseBR PortugueseVariant = iota
+	PortuguesePT
+)
+
+// Portuguese deletrea en portugués. En pt-BR 10^9 es BILHÃO (escala corta);
+// en pt-PT es MIL MILHÕES y BILIÃO queda para 10^12. Feminino concuerda la
+// parte entera con monedas femeninas (DUZENTAS LIBRAS, UMA LIBRA).
+type Portuguese struct {
+	Conector string
+	Feminino bool
+	variant  PortugueseVariant
+	unidades []string
+}
+
+var (
+	portugueseUnitsBR  = []string{"", "UM", "DOIS", "TRÊS", "QUATRO", "CINCO", "SEIS", "SETE", "OITO", "NOVE", "DEZ", "ONZE", "DOZE", "TREZE", "CATORZE", "QUINZE", "DEZESSEIS", "DEZESSETE", "DEZOITO", "DEZENOVE"}
+	portugueseUnitsPT  = []string{"", "UM", "DOIS", "TRÊS", "QUATRO", "CINCO", "SEIS", "SETE", "OITO", "NOVE", "DEZ", "ONZE", "DOZE", "TREZE", "CATORZE", "QUINZE", "DEZASSEIS", "DEZASSETE", "DEZOITO", "DEZANOVE"}
+	portugueseTens     = []string{"", "", "VINTE", "TRINTA", "QUARENTA", "CINQUENTA", "SESSENTA", "SETENTA", "OITENTA", "NOVENTA"}
+	portugueseHundreds = []string{"", "CENTO", "DUZENTOS", "TREZENTOS", "QUATROCENTOS", "QUINHENTOS", "SEISCENTOS", "SETECENTOS", "OITOCENTOS", "NOVECENTOS"}
+	portugueseShort    = [][2]string{{}, {"MIL", "MIL"}, {"MILHÃO", "MILHÕES"}, {"BILHÃO", "BILHÕES"}, {"TRILHÃO", "TRILHÕES"}, {"QUATRILHÃO", "QUATRILHÕES"}}
+	portugueseLong     = [][2]string{{}, {"MILHÃO", "MILHÕES"}, {"BILIÃO", "BILIÕES"}}
+)
+
+func NewPortuguese(variant PortugueseVariant) *Portuguese {
+	p := &Portuguese{Conector: "E", variant: variant, unidades: portugueseUnitsBR}
+	if variant == PortuguesePT {
+		p.unidades = portugueseUnitsPT
+	}
+	return p
+}
+
+func init() {
+	Register("pt", func() Speller { return NewPortuguese(PortugueseBR) })
+	Register("pt-BR", func() Speller { return NewPortuguese(PortugueseBR) })
+	Register("pt-PT", func() Speller { return NewPortuguese(PortuguesePT) })
+}
+
+func (p *Portuguese) ToWords(number float64, decimals int) (string, error) {
+	factor := math.Pow(10, float64(decimals))
+	number = math.Round(number*factor) / factor
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := p.wholeNumber(whole, p.Feminino)
+	if err != nil {
+		return "", err
+	}
+	var decimal string
+	if fraction != "" && !isZero(fraction) {
+		decimal, err = p.wholeNumber(fraction, false)
+		if err != nil {
+			return "", err
+		}
+	}
+	return p.concat(wholeWords, decimal), nil
+}
+
+func (p *Portuguese) ToMoney(number float64, decimals int, currency, cents string) (string, error) {
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := p.wholeNumber(whole, p.Feminino)
+	if err != nil {
+		return "", err
+	}
+	if isRoundScale(whole) {
+		wholeWords += " DE"
+	}
+	wholeWords += " " + strings.ToUpper(currency)
+
+	var decimal string
+	if fraction != "" && !isZero(fraction) {
+		decimal, err = p.wholeNumber(fraction, false)
+		if err != nil {
+			return "", err
+		}
+		decimal += " " + strings.ToUpper(cents)
+	}
+	return p.concat(wholeWords, decimal), nil
+}
+
+func (p *Portuguese) ToInvoice(number float64, decimals int, currency string) (string, error) {
+	whole, fraction, err := splitSinSigno(number, decimals)
+	if err != nil {
+		return "", err
+	}
+
+	wholeWords, err := p.wholeNumber(whole, p.Feminino)
+	if err != nil {
+		return "", err
+	}
+	decimal := "00/100"
+	if fraction != "" {
+		decimal = fmt.Sprintf("%02d/100", mustAtoi(fraction))
+	}
+	return p.concat(wholeWords, decimal) + " " + strings.ToUpper(currency), nil
+}
+
+func (p *Portuguese) wholeNumber(number string, feminino bool) (string, error) {
+	num, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	if num >= 1e18 {
+		return "", fmt.Errorf("numeroaletras: número fuera de rango %s", number)
+	}
+	if num == 0 {
+		return "ZERO", nil
+	}
+	return p.join(p.pieces(num, feminino)), nil
+}
+
+func (p *Portuguese) concat(whole, decimal string) string {
+	if decimal == "" {
+		return whole
+	}
+	return whole + " " + strings.ToUpper(p.Conector) + " " + decimal
+}
+
+// portuguesePiece es un grupo ya deletreado junto con el valor que decide si
+// lleva "E" delante: solo si es menor que cien o una centena redonda.
+type portuguesePiece struct {
+	value int
+	words string
+}
+
+func (p *Portuguese) pieces(num uint64, feminino bool) []portuguesePiece {
+	if p.variant == PortuguesePT && num >= 1e6 {
+		var res []portuguesePiece
+		for j := 2; j >= 1; j-- {
+			div := uint64(math.Pow10(6 * j))
+			block := num / div % 1e6
+			if block == 0 {
+				continue
+			}
+			scale := portugueseLong[j][1]
+			if block == 1 {
+				scale = portugueseLong[j][0]
+			}
+			value := 101
+			switch {
+			case block%1000 == 0:
+				value = int(block / 1000)
+			case block < 1000:
+				value = int(block)
+			}
+			res = append(res, portuguesePiece{value: value, words: p.join(p.pieces(block, false)) + " " + scale})
+		}
+		return append(res, p.pieces(num%1e6, feminino)...)
+	}
+
+	var res []portuguesePiece
+	for i := len(portugueseShort) - 1; i >= 0; i-- {
+		g := int(num / uint64(math.Pow10(3*i)) % 1000)
+		if g == 0 {
+			continue
+		}
+		var words string
+		switch {
+		case i == 0:
+			words = p.convertGroup(g, feminino)
+		case i == 1 && g == 1:
+			words = "MIL"
+		case i == 1:
+			words = p.convertGroup(g, feminino) + " MIL"
+		case g == 1:
+			words = "UM " + portugueseShort[i][0]
+		default:
+			words = p.convertGroup(g, false) + " " + portugueseShort[i][1]
+		}
+		res = append(res, portuguesePiece{value: g, words: words})
+	}
+	return res
+}
+
+func (p *Portuguese) join(pieces []portuguesePiece) string {
+	words := make([]string, 0, len(pieces)+1)
+	for i, piece := range pieces {
+		if i > 0 && i == len(pieces)-1 && (piece.value < 100 || piece.value%100 == 0) {
+			words = append(words, "E")
+		}
+		words = append(words, piece.words)
+	}
+	return strings.Join(words, " ")
+}
+
+func (p *Portuguese) convertGroup(g int, feminino bool) string {
+	if g == 100 {
+		return "CEM"
+	}
+	h, rest := g/100, g%100
+
+	var parts []string
+	if h > 0 {
+		hundred := portugueseHundreds[h]
+		if feminino && h > 1 {
+			hundred = strings.TrimSuffix(hundred, "OS") + "AS"
+		}
+		parts = append(parts, hundred)
+	}
+	switch {
+	case rest == 0:
+	case rest < 20:
+		parts = append(parts, p.unit(rest, feminino))
+	case rest%10 == 0:
+		parts = append(parts, portugueseTens[rest/10])
+	default:
+		parts = append(parts, portugueseTens[rest/10], p.unit(rest%10, feminino))
+	}
+	return strings.Join(parts, " E ")
+}
+
+func (p *Portuguese) unit(u int, feminino bool) string {
+	if feminino {
+		switch u {
+		case 1:
+			return "UMA"
+		case 2:
+			return "DUAS"
+		}
+	}
+	return p.unidades[u]
+}
+
+// isRoundScale indica si el entero termina en millones o más sin resto
+// (1000000, 2000000000), caso en que la moneda va precedida de "DE".
+func isRoundScale(digits string) bool {
+	trimmed := strings.TrimLeft(digits, "0")
+	return len(trimmed) > 6 && isZero(trimmed[len(trimmed)-6:])
+}
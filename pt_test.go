@@ -0,0 +1,108 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestPortugueseToWords(t *testing.T) {
+	tests := map[string]struct {
+		variant  PortugueseVariant
+		feminino bool
+		number   float64
+		expected string
+	}{
+		"Zero":                {variant: PortugueseBR, number: 0, expected: "ZERO"},
+		"Cem":                 {variant: PortugueseBR, number: 100, expected: "CEM"},
+		"Cento e um":          {variant: PortugueseBR, number: 101, expected: "CENTO E UM"},
+		"Dezesseis BR":        {variant: PortugueseBR, number: 16, expected: "DEZESSEIS"},
+		"Dezasseis PT":        {variant: PortuguesePT, number: 16, expected: "DEZASSEIS"},
+		"Dezanove PT":         {variant: PortuguesePT, number: 19, expected: "DEZANOVE"},
+		"Mil e cem":           {variant: PortugueseBR, number: 1100, expected: "MIL E CEM"},
+		"Mil sem e":           {variant: PortugueseBR, number: 1234, expected: "MIL DUZENTOS E TRINTA E QUATRO"},
+		"Mil e cinco":         {variant: PortugueseBR, number: 1005, expected: "MIL E CINCO"},
+		"Um milhão":           {variant: PortugueseBR, number: 1000000, expected: "UM MILHÃO"},
+		"Milhão e quinhentos": {variant: PortugueseBR, number: 1500000, expected: "UM MILHÃO E QUINHENTOS MIL"},
+		"Bilhão BR":           {variant: PortugueseBR, number: 2e9, expected: "DOIS BILHÕES"},
+		"Mil milhões PT":      {variant: PortuguesePT, number: 2e9, expected: "DOIS MIL MILHÕES"},
+		"Mil e quinhentos PT": {variant: PortuguesePT, number: 1.5e9, expected: "MIL E QUINHENTOS MILHÕES"},
+		"Bilião PT":           {variant: PortuguesePT, number: 1e12, expected: "UM BILIÃO"},
+		"Bilião e milhões PT": {variant: PortuguesePT, number: 1000002000001, expected: "UM BILIÃO DOIS MILHÕES E UM"},
+		"Feminino duzentas":   {variant: PortugueseBR, feminino: true, number: 201, expected: "DUZENTAS E UMA"},
+		"Feminino mil":        {variant: PortugueseBR, feminino: true, number: 2002, expected: "DUAS MIL E DUAS"},
+		"Feminino milhões":    {variant: PortugueseBR, feminino: true, number: 200000000, expected: "DUZENTOS MILHÕES"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			p := NewPortuguese(tt.variant)
+			p.Feminino = tt.feminino
+			words, err := p.ToWords(tt.number, 0)
+			if err != nil {
+				t.Fatalf("ToWords(%v) returned error: %v", tt.number, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToWords(%v) = %v; want %v", tt.number, words, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPortugueseToMoney(t *testing.T) {
+	tests := map[string]struct {
+		number   float64
+		currency string
+		cents    string
+		expected string
+	}{
+		"Reais e centavos": {number: 1234.50, currency: "reais", cents: "centavos", expected: "MIL DUZENTOS E TRINTA E QUATRO REAIS E CINQUENTA CENTAVOS"},
+		"Sem centavos":     {number: 20, currency: "REAIS", cents: "CENTAVOS", expected: "VINTE REAIS"},
+		"Milhão de reais":  {number: 3000000, currency: "REAIS", cents: "CENTAVOS", expected: "TRÊS MILHÕES DE REAIS"},
+		"Só centavos":      {number: 0.01, currency: "REAIS", cents: "CENTAVOS", expected: "ZERO REAIS E UM CENTAVOS"},
+	}
+
+	p := NewPortuguese(PortugueseBR)
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			money, err := p.ToMoney(tt.number, 2, tt.currency, tt.cents)
+			if err != nil {
+				t.Fatalf("ToMoney(%v) returned error: %v", tt.number, err)
+			}
+			if money != tt.expected {
+				t.Errorf("ToMoney(%v) = %v; want %v", tt.number, money, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPortugueseToInvoice(t *testing.T) {
+	p := NewPortuguese(PortuguesePT)
+	p.Feminino = true
+	invoice, err := p.ToInvoice(201.5, 2, "libras")
+	if err != nil {
+		t.Fatalf("ToInvoice returned error: %v", err)
+	}
+	if expected := "DUZENTAS E UMA E 50/100 LIBRAS"; invoice != expected {
+		t.Errorf("ToInvoice(201.5) = %v; want %v", invoice, expected)
+	}
+}
+
+func TestPortugueseErrors(t *testing.T) {
+	p := NewPortuguese(PortuguesePT)
+	if _, err := p.ToWords(-5, 0); err == nil || err.Error() != "numeroaletras: número negativo -5" {
+		t.Errorf("ToWords(-5) = %v; want numeroaletras: número negativo -5", err)
+	}
+	if words, err := p.ToWords(-0.2, 0); err != nil || words != "ZERO" {
+		t.Errorf("ToWords(-0.2, 0) = %v, %v; want ZERO", words, err)
+	}
+	if money, err := p.ToMoney(-0.5, 2, "reais", "centavos"); err == nil || err.Error() != "numeroaletras: número negativo -0.50" {
+		t.Errorf("ToMoney(-0.5) = %v, %v; want numeroaletras: número negativo -0.50", money, err)
+	}
+	if invoice, err := p.ToInvoice(-0.5, 2, "reais"); err == nil || err.Error() != "numeroaletras: número negativo -0.50" {
+		t.Errorf("ToInvoice(-0.5) = %v, %v; want numeroaletras: número negativo -0.50", invoice, err)
+	}
+	if _, err := p.ToWords(1e18, 0); err == nil || err.Error() != "numeroaletras: número fuera de rango 1000000000000000000" {
+		t.Errorf("ToWords(1e18) = %v; want numeroaletras: número fuera de rango 1000000000000000000", err)
+	}
+}
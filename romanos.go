@@ -0,0 +1,127 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"strings"
+)
+
+const vinculum = '\u0305'
+
+var romanos = []struct {
+	valor   int
+	simbolo string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+var valoresRomanos = map[rune]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100, 'D': 500, 'M': 1000}
+
+func ToRoman(number int) (string, error) {
+	if number < 1 || number > 3999 {
+		return "", fmt.Errorf("numeroaletras: %d no se puede escribir en romanos (1-3999)", number)
+	}
+	var res strings.Builder
+	for _, r := range romanos {
+		for number >= r.valor {
+			res.WriteString(r.simbolo)
+			number -= r.valor
+		}
+	}
+	return res.String(), nil
+}
+
+// ToRomanVinculum amplía el rango hasta 3999999 con la raya superior
+// (U+0305), que multiplica por mil: 5000 es V̅.
+func ToRomanVinculum(number int) (string, error) {
+	if number < 4000 {
+		return ToRoman(number)
+	}
+	if number > 3999999 {
+		return "", fmt.Errorf("numeroaletras: %d no se puede escribir en romanos (1-3999999)", number)
+	}
+	miles, _ := ToRoman(number / 1000)
+	var res strings.Builder
+	for _, r := range miles {
+		res.WriteRune(r)
+		res.WriteRune(vinculum)
+	}
+	if rest := number % 1000; rest > 0 {
+		resto, _ := ToRoman(rest)
+		res.WriteString(resto)
+	}
+	return res.String(), nil
+}
+
+// ParseRoman acepta solo la forma canónica ("IIII" o "IC" son error),
+// con o sin raya superior.
+func ParseRoman(s string) (int, error) {
+	roman := strings.ToUpper(strings.TrimSpace(s))
+	if roman == "" {
+		return 0, fmt.Errorf("numeroaletras: número romano vacío")
+	}
+
+	var miles, unidades []rune
+	for _, r := range roman {
+		if r == vinculum {
+			if len(unidades) != 1 {
+				return 0, fmt.Errorf("numeroaletras: número romano inválido %q", s)
+			}
+			miles = append(miles, unidades[len(unidades)-1])
+			unidades = unidades[:len(unidades)-1]
+			continue
+		}
+		unidades = append(unidades, r)
+	}
+
+	value, err := sumarRomanos(unidades)
+	if err != nil {
+		return 0, fmt.Errorf("numeroaletras: número romano inválido %q", s)
+	}
+	if len(miles) > 0 {
+		m, err := sumarRomanos(miles)
+		if err != nil || value >= 1000 {
+			return 0, fmt.Errorf("numeroaletras: número romano inválido %q", s)
+		}
+		value += m * 1000
+	}
+
+	canonical, err := ToRomanVinculum(value)
+	if err != nil || canonical != roman {
+		return 0, fmt.Errorf("numeroaletras: número romano inválido %q", s)
+	}
+	return value, nil
+}
+
+func sumarRomanos(roman []rune) (int, error) {
+	total := 0
+	for i, r := range roman {
+		v, ok := valoresRomanos[r]
+		if !ok {
+			return 0, fmt.Errorf("numeroaletras: símbolo romano inválido %q", r)
+		}
+		if i+1 < len(roman) && v < valoresRomanos[roman[i+1]] {
+			total -= v
+		} else {
+			total += v
+		}
+	}
+	return total, nil
+}
+
+func (n *NumeroALetras) RomanToWords(s string) (string, error) {
+	value, err := ParseRoman(s)
+	if err != nil {
+		return "", err
+	}
+	return n.convertNumber(value), nil
+}
+
+func (n *NumeroALetras) RomanToOrdinal(s string, genero Genero) (string, error) {
+	value, err := ParseRoman(s)
+	if err != nil {
+		return "", err
+	}
+	return n.ToOrdinal(value, genero)
+}
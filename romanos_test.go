@@ -0,0 +1,91 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestToRoman(t *testing.T) {
+	tests := map[int]string{
+		1:    "I",
+		4:    "IV",
+		14:   "XIV",
+		21:   "XXI",
+		1994: "MCMXCIV",
+		2026: "MMXXVI",
+		3999: "MMMCMXCIX",
+	}
+	for number, expected := range tests {
+		got, err := ToRoman(number)
+		if err != nil {
+			t.Fatalf("ToRoman(%d) retornó error: %v", number, err)
+		}
+		if got != expected {
+			t.Errorf("ToRoman(%d) = %v; se esperaba %v", number, got, expected)
+		}
+		back, err := ParseRoman(got)
+		if err != nil || back != number {
+			t.Errorf("ParseRoman(%q) = %d, %v; se esperaba %d", got, back, err, number)
+		}
+	}
+	for _, number := range []int{0, -3, 4000} {
+		if _, err := ToRoman(number); err == nil {
+			t.Errorf("ToRoman(%d) expected error, got nil", number)
+		}
+	}
+}
+
+func TestToRomanVinculum(t *testing.T) {
+	tests := map[int]string{
+		3999:    "MMMCMXCIX",
+		4000:    "I\u0305V\u0305",
+		5001:    "V\u0305I",
+		1000000: "M\u0305",
+		3999999: "M\u0305M\u0305M\u0305C\u0305M\u0305X\u0305C\u0305I\u0305X\u0305CMXCIX",
+	}
+	for number, expected := range tests {
+		got, err := ToRomanVinculum(number)
+		if err != nil {
+			t.Fatalf("ToRomanVinculum(%d) retornó error: %v", number, err)
+		}
+		if got != expected {
+			t.Errorf("ToRomanVinculum(%d) = %q; se esperaba %q", number, got, expected)
+		}
+		back, err := ParseRoman(got)
+		if err != nil || back != number {
+			t.Errorf("ParseRoman(%q) = %d, %v; se esperaba %d", got, back, err, number)
+		}
+	}
+	if _, err := ToRomanVinculum(4000000); err == nil {
+		t.Error("ToRomanVinculum(4000000) expected error, got nil")
+	}
+}
+
+func TestParseRoman_Invalidos(t *testing.T) {
+	for _, roman := range []string{"", "IIII", "IC", "VX", "ABC", "MMMM", "I\u0305", "\u0305V", "XV\u0305"} {
+		if got, err := ParseRoman(roman); err == nil {
+			t.Errorf("ParseRoman(%q) = %d; expected error", roman, got)
+		}
+	}
+	if got, err := ParseRoman(" xiv "); err != nil || got != 14 {
+		t.Errorf("ParseRoman(\" xiv \") = %d, %v; se esperaba 14", got, err)
+	}
+}
+
+func TestRomanToWords(t *testing.T) {
+	n := NewNumeroALetras()
+	words, err := n.RomanToWords("XXI")
+	if err != nil || words != "VEINTIUNO" {
+		t.Errorf("RomanToWords(XXI) = %v, %v; se esperaba VEINTIUNO", words, err)
+	}
+	ordinal, err := n.RomanToOrdinal("XXI", Masculino)
+	if err != nil || ordinal != "VIGÉSIMO PRIMERO" {
+		t.Errorf("RomanToOrdinal(XXI) = %v, %v; se esperaba VIGÉSIMO PRIMERO", ordinal, err)
+	}
+	ordinal, err = n.RomanToOrdinal("XIV", Femenino)
+	if err != nil || ordinal != "DECIMOCUARTA" {
+		t.Errorf("RomanToOrdinal(XIV) = %v, %v; se esperaba DECIMOCUARTA", ordinal, err)
+	}
+	if _, err := n.RomanToWords("IIII"); err == nil {
+		t.Error("RomanToWords(IIII) expected error, got nil")
+	}
+}
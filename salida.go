@@ -0,0 +1,119 @@
+package numeroaletras
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// salida recibe las piezas del resultado. En modo texto las escribe en buf
+// separadas por un espacio, sin reservar memoria; si no, arma los tokens.
+type salida struct {
+	texto   bool
+	buf     []byte
+	escrito bool
+	tokens  []Token
+}
+
+func (s *salida) add(kind TokenKind, text string, digits []byte) {
+	if !s.texto {
+		s.tokens = append(s.tokens, Token{Kind: kind, Text: text, Digits: string(digits)})
+		return
+	}
+	// Mismo resultado que Render: las palabras se separan con un solo espacio.
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		if unicode.IsSpace(r) {
+			text = text[size:]
+			continue
+		}
+		end := 0
+		for end < len(text) {
+			r, size := utf8.DecodeRuneInString(text[end:])
+			if unicode.IsSpace(r) {
+				break
+			}
+			end += size
+		}
+		s.separar()
+		s.buf = append(s.buf, text[:end]...)
+		text = text[end:]
+	}
+}
+
+// fraccion escribe los decimales de factura como %02d/100.
+func (s *salida) fraccion(digits []byte) {
+	v := trimCeros(digits)
+	if !s.texto {
+		text := strings.Repeat("0", max(2-len(v), 0)) + string(v) + "/100"
+		if len(digits) == 0 {
+			digits = []byte("00")
+		}
+		s.tokens = append(s.tokens, Token{Kind: TokenFraction, Text: text, Digits: string(digits)})
+		return
+	}
+	s.separar()
+	for i := len(v); i < 2; i++ {
+		s.buf = append(s.buf, '0')
+	}
+	s.buf = append(s.buf, v...)
+	s.buf = append(s.buf, "/100"...)
+}
+
+func (s *salida) separar() {
+	if s.escrito {
+		s.buf = append(s.buf, ' ')
+	}
+	s.escrito = true
+}
+
+// AppendWords agrega a dst el mismo texto que ToWords. Con dst de capacidad
+// suficiente no reserva memoria, lo que sirve en lotes grandes.
+func (n *NumeroALetras) AppendWords(dst []byte, number float64, decimals int) ([]byte, error) {
+	var buf [32]byte
+	whole, fraction := splitFloat(buf[:0], n.redondear(number, decimals), decimals)
+	s := salida{texto: true, buf: dst}
+	if err := n.escribirWords(&s, whole, fraction); err != nil {
+		return dst, err
+	}
+	return s.buf, nil
+}
+
+func (n *NumeroALetras) AppendMoney(dst []byte, number float64, decimals int, currency, cents string) ([]byte, error) {
+	var buf [32]byte
+	whole, fraction := splitFloat(buf[:0], number, decimals)
+	s := salida{texto: true, buf: dst}
+	if err := n.escribirMoney(&s, whole, fraction, currency, cents); err != nil {
+		return dst, err
+	}
+	return s.buf, nil
+}
+
+func (n *NumeroALetras) AppendInvoice(dst []byte, number float64, decimals int, currency string) ([]byte, error) {
+	var buf [32]byte
+	whole, fraction := splitFloat(buf[:0], number, decimals)
+	s := salida{texto: true, buf: dst}
+	if err := n.escribirInvoice(&s, whole, fraction, currency); err != nil {
+		return dst, err
+	}
+	return s.buf, nil
+}
+
+// AppendInt agrega a dst un entero en letras, sin pasar por float64.
+func (n *NumeroALetras) AppendInt(dst []byte, number int64) []byte {
+	var buf [24]byte
+	s := salida{texto: true, buf: dst}
+	// Un int64 siempre está dentro de rango.
+	_ = n.escribirEntero(&s, strconv.AppendInt(buf[:0], number, 10), nil)
+	return s.buf
+}
+
+func splitFloat(buf []byte, number float64, decimals int) (whole, fraction []byte) {
+	b := strconv.AppendFloat(buf, number, 'f', max(decimals, 0), 64)
+	if i := bytes.IndexByte(b, '.'); i >= 0 {
+		return b[:i], b[i+1:]
+	}
+	return b, nil
+}
@@ -0,0 +1,80 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestAppendWords(t *testing.T) {
+	n := NewNumeroALetras()
+
+	numbers := []float64{0, 1, 21, 100, 101, 1001, 21000, 1000000, 1234567.89, 2000000000, -15.5, 999999999999.99}
+	for _, number := range numbers {
+		expected, _ := n.ToWords(number, 2)
+		got, err := n.AppendWords([]byte("X: "), number, 2)
+		if err != nil {
+			t.Fatalf("AppendWords(%v) returned error: %v", number, err)
+		}
+		if string(got) != "X: "+expected {
+			t.Errorf("AppendWords(%v) = %q; want %q", number, got, "X: "+expected)
+		}
+	}
+
+	money, _ := n.AppendMoney(nil, 1700.5, 2, "soles", "céntimos")
+	if expected, _ := n.ToMoney(1700.5, 2, "soles", "céntimos"); string(money) != expected {
+		t.Errorf("AppendMoney = %q; want %q", money, expected)
+	}
+	invoice, _ := n.AppendInvoice(nil, 1700.5, 2, "SOLES")
+	if expected, _ := n.ToInvoice(1700.5, 2, "SOLES"); string(invoice) != expected {
+		t.Errorf("AppendInvoice = %q; want %q", invoice, expected)
+	}
+	if got := n.AppendInt(nil, -9223372036854775808); string(got) != "MENOS NUEVE TRILLONES DOSCIENTOS VEINTITRÉS MIL TRESCIENTOS SETENTA Y DOS BILLONES TREINTA Y SEIS MIL OCHOCIENTOS CINCUENTA Y CUATRO MILLONES SETECIENTOS SETENTA Y CINCO MIL OCHOCIENTOS OCHO" {
+		t.Errorf("AppendInt(MinInt64) = %q", got)
+	}
+
+	dst := []byte("sin cambios")
+	if got, err := n.AppendWords(dst, 1e30, 0); err == nil || string(got) != "sin cambios" {
+		t.Errorf("AppendWords(1e30) = %q, %v; want dst unchanged and error", got, err)
+	}
+}
+
+func TestAppendWords_SinAsignaciones(t *testing.T) {
+	n := NewNumeroALetras()
+	n.UseApocope(true)
+	buf := make([]byte, 0, 512)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = n.AppendWords(buf[:0], 1234567.89, 2)
+		buf, _ = n.AppendMoney(buf[:0], 21001.05, 2, "SOLES", "CÉNTIMOS")
+		buf, _ = n.AppendInvoice(buf[:0], 999999.99, 2, "DÓLARES")
+		buf = n.AppendInt(buf[:0], 9007199254740993)
+	})
+	if allocs != 0 {
+		t.Errorf("Append* allocs = %v; want 0", allocs)
+	}
+}
+
+func BenchmarkToWords(b *testing.B) {
+	n := NewNumeroALetras()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = n.ToWords(1234567.89, 2)
+	}
+}
+
+func BenchmarkAppendWords(b *testing.B) {
+	n := NewNumeroALetras()
+	buf := make([]byte, 0, 256)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = n.AppendWords(buf[:0], 1234567.89, 2)
+	}
+}
+
+func BenchmarkAppendInvoice(b *testing.B) {
+	n := NewNumeroALetras()
+	buf := make([]byte, 0, 256)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = n.AppendInvoice(buf[:0], float64(i%1000000)+0.5, 2, "SOLES")
+	}
+}
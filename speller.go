@@ -0,0 +1,84 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type Speller interface {
+	ToWords(number float64, decimals int) (string, error)
+	ToMoney(number float64, decimals int, currency, cents string) (string, error)
+	ToInvoice(number float64, decimals int, currency string) (string, error)
+}
+
+var (
+	spellersMu sync.RWMutex
+	spellers   = make(map[string]func() Speller)
+)
+
+// Register asocia una etiqueta BCP 47 (es-PE, en-US...) con la función que
+// construye su Speller. Igual que database/sql, entra en pánico si la
+// etiqueta ya está registrada.
+func Register(tag string, factory func() Speller) {
+	spellersMu.Lock()
+	defer spellersMu.Unlock()
+	if factory == nil {
+		panic("numeroaletras: Register factory is nil")
+	}
+	key := canonicalTag(tag)
+	if _, dup := spellers[key]; dup {
+		panic("numeroaletras: Register called twice for locale " + key)
+	}
+	spellers[key] = factory
+}
+
+// NewSpeller devuelve un Speller nuevo para la etiqueta. Si la variante
+// regional no existe se recorta la etiqueta (es-BO → es).
+func NewSpeller(tag string) (Speller, error) {
+	spellersMu.RLock()
+	defer spellersMu.RUnlock()
+	key := canonicalTag(tag)
+	for key != "" {
+		if factory, ok := spellers[key]; ok {
+			return factory(), nil
+		}
+		i := strings.LastIndexByte(key, '-')
+		if i < 0 {
+			break
+		}
+		key = key[:i]
+	}
+	return nil, fmt.Errorf("numeroaletras: idioma no registrado %q", tag)
+}
+
+func Locales() []string {
+	spellersMu.RLock()
+	defer spellersMu.RUnlock()
+	tags := make([]string, 0, len(spellers))
+	for tag := range spellers {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+func canonicalTag(tag string) string {
+	parts := strings.FieldsFunc(strings.TrimSpace(tag), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	for i, part := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(part)
+		case len(part) == 2 || (len(part) == 3 && isDigits(part)):
+			parts[i] = strings.ToUpper(part)
+		case len(part) == 4:
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		default:
+			parts[i] = strings.ToLower(part)
+		}
+	}
+	return strings.Join(parts, "-")
+}
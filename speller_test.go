@@ -0,0 +1,107 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestNewSpeller(t *testing.T) {
+	tests := map[string]struct {
+		tag      string
+		expected string
+		wantErr  bool
+	}{
+		"Perú":                 {tag: "es-PE", expected: "MIL DOSCIENTOS TREINTA Y CUATRO CON 50/100 SOLES"},
+		"Minúsculas y guion":   {tag: "es_pe", expected: "MIL DOSCIENTOS TREINTA Y CUATRO CON 50/100 SOLES"},
+		"Región no registrada": {tag: "es-BO", expected: "MIL DOSCIENTOS TREINTA Y CUATRO CON 50/100 SOLES"},
+		"Idioma desconocido":   {tag: "xx-YY", wantErr: true},
+		"Etiqueta vacía":       {tag: "", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			s, err := NewSpeller(tt.tag)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("NewSpeller(%q) expected error, got nil", tt.tag)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewSpeller(%q) returned error: %v", tt.tag, err)
+			}
+			invoice, err := s.ToInvoice(1234.50, 2, "soles")
+			if err != nil {
+				t.Fatalf("ToInvoice returned error: %v", err)
+			}
+			if invoice != tt.expected {
+				t.Errorf("NewSpeller(%q).ToInvoice = %v; want %v", tt.tag, invoice, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Register duplicado no entró en pánico")
+		}
+	}()
+	Register("ES-pe", func() Speller { return NewNumeroALetras() })
+}
+
+func TestCanonicalTag(t *testing.T) {
+	tests := map[string]string{
+		"es-pe":      "es-PE",
+		"EN_us":      "en-US",
+		"es-419":     "es-419",
+		"sr-latn-rs": "sr-Latn-RS",
+		" pt-BR ":    "pt-BR",
+	}
+	for tag, expected := range tests {
+		if got := canonicalTag(tag); got != expected {
+			t.Errorf("canonicalTag(%q) = %q; want %q", tag, got, expected)
+		}
+	}
+}
+
+func TestLocales(t *testing.T) {
+	found := map[string]bool{}
+	for _, tag := range Locales() {
+		found[tag] = true
+	}
+	for _, tag := range []string{"es", "es-PE", "es-MX", "es-ES"} {
+		if !found[tag] {
+			t.Errorf("Locales() no incluye %q", tag)
+		}
+	}
+}
+
+func TestRegionEspanol(t *testing.T) {
+	tests := map[string]struct {
+		tag      string
+		expected string
+	}{
+		"Puerto Rico": {tag: "es-PR", expected: "DOS BILLONES QUINIENTOS MILLONES CON CINCO"},
+		"España":      {tag: "es-ES", expected: "DOS MIL QUINIENTOS MILLONES CON CINCO"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			s, err := NewSpeller(tt.tag)
+			if err != nil {
+				t.Fatalf("NewSpeller(%q) returned error: %v", tt.tag, err)
+			}
+			words, err := s.ToWords(2500000000.5, 1)
+			if err != nil || words != tt.expected {
+				t.Errorf("NewSpeller(%q).ToWords = %v, %v; want %v", tt.tag, words, err, tt.expected)
+			}
+		})
+	}
+
+	s := RegionEspanol{Conector: "Y", Escala: EscalaCorta, Apocope: true}.Speller()
+	if money, _ := s.ToMoney(2000000021, 0, "pesos", "centavos"); money != "DOS BILLONES VEINTIÚN PESOS" {
+		t.Errorf("RegionEspanol.ToMoney = %v", money)
+	}
+}
@@ -0,0 +1,114 @@
+package numeroaletras
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SSML genera marcado <speak> para motores de voz a partir de los mismos
+// tokens que el texto plano. Inserta una pausa antes del conector, lee las
+// fracciones de factura (50/100) como CINCUENTA SOBRE CIEN y pronuncia los
+// códigos de moneda conocidos por su nombre; los desconocidos se deletrean.
+type SSML struct {
+	Numero *NumeroALetras
+	Pausa  time.Duration
+}
+
+func NewSSML(n *NumeroALetras) *SSML {
+	return &SSML{Numero: n, Pausa: 300 * time.Millisecond}
+}
+
+func (s *SSML) Words(number float64, decimals int) (string, error) {
+	number = s.Numero.redondear(number, decimals)
+	whole, fraction := splitNumber(number, decimals)
+	tokens, err := s.Numero.wordsTokens(whole, fraction)
+	if err != nil {
+		return "", err
+	}
+	return s.render(tokens), nil
+}
+
+func (s *SSML) Money(number float64, decimals int, currency, cents string) (string, error) {
+	whole, fraction := splitNumber(number, decimals)
+	tokens, err := s.Numero.moneyTokens(whole, fraction, currency, cents)
+	if err != nil {
+		return "", err
+	}
+	return s.render(tokens), nil
+}
+
+func (s *SSML) Invoice(number float64, decimals int, currency string) (string, error) {
+	whole, fraction := splitNumber(number, decimals)
+	tokens, err := s.Numero.invoiceTokens(whole, fraction, currency)
+	if err != nil {
+		return "", err
+	}
+	return s.render(tokens), nil
+}
+
+func (s *SSML) render(tokens []Token) string {
+	parts := []string{}
+	for _, t := range tokens {
+		text := strings.Join(strings.Fields(t.Text), " ")
+		if text == "" {
+			continue
+		}
+		switch t.Kind {
+		case TokenConnector:
+			if s.Pausa > 0 {
+				parts = append(parts, fmt.Sprintf(`<break time="%dms"/>`, s.Pausa.Milliseconds()))
+			}
+			parts = append(parts, escapeSSML(text))
+		case TokenFraction:
+			numerator := "CERO"
+			if !isZero(t.Digits) {
+				numerator = s.Numero.sinApocope().convertNumber(mustAtoi(t.Digits))
+			}
+			parts = append(parts, fmt.Sprintf(`<sub alias="%s SOBRE CIEN">%s</sub>`, escapeSSML(numerator), escapeSSML(text)))
+		case TokenCurrency, TokenMinorUnit:
+			parts = append(parts, currencySSML(text))
+		default:
+			parts = append(parts, escapeSSML(text))
+		}
+	}
+	return "<speak>" + strings.Join(parts, " ") + "</speak>"
+}
+
+// codigosISO son los códigos ISO 4217 vigentes. Solo estos se deletrean
+// letra por letra; una palabra como SOL se lee normalmente.
+var codigosISO = func() map[string]bool {
+	set := make(map[string]bool)
+	for _, c := range strings.Fields(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND
+	BOB BOV BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU
+	CRC CUP CVE CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP
+	GMD GNF GTQ GYD HKD HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES
+	KGS KHR KMF KPW KRW KWD KYD KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD
+	MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD OMR
+	PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB RWF SAR SBD SCR SDG SEK SGD
+	SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS TMT TND TOP TRY TTD TWD TZS
+	UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF XAG XAU XBA XBB
+	XBC XBD XCD XDR XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW ZWG`) {
+		set[c] = true
+	}
+	return set
+}()
+
+func currencySSML(text string) string {
+	if c, ok := CurrencyByCode(text); ok {
+		return fmt.Sprintf(`<sub alias="%s">%s</sub>`, escapeSSML(c.Name), escapeSSML(text))
+	}
+	if codigosISO[text] {
+		return fmt.Sprintf(`<say-as interpret-as="characters">%s</say-as>`, text)
+	}
+	return escapeSSML(text)
+}
+
+func escapeSSML(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,87 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestSSML(t *testing.T) {
+	s := NewSSML(NewNumeroALetras())
+
+	words, err := s.Words(100.99, 2)
+	if err != nil {
+		t.Fatalf("Words returned error: %v", err)
+	}
+	if expected := `<speak>CIEN <break time="300ms"/> CON NOVENTA Y NUEVE</speak>`; words != expected {
+		t.Errorf("Words(100.99) = %v; want %v", words, expected)
+	}
+
+	tests := map[string]struct {
+		number   float64
+		currency string
+		cents    string
+		expected string
+	}{
+		"Nombre de moneda": {
+			number:   1234.50,
+			currency: "soles",
+			cents:    "céntimos",
+			expected: `<speak>MIL DOSCIENTOS TREINTA Y CUATRO SOLES <break time="300ms"/> CON CINCUENTA CÉNTIMOS</speak>`,
+		},
+		"Código conocido": {
+			number:   20,
+			currency: "USD",
+			cents:    "CENTAVOS",
+			expected: `<speak>VEINTE <sub alias="DÓLARES">USD</sub></speak>`,
+		},
+		"Código ISO sin nombre": {
+			number:   3.05,
+			currency: "XAU",
+			cents:    "CENTAVOS",
+			expected: `<speak>TRES <say-as interpret-as="characters">XAU</say-as> <break time="300ms"/> CON CINCO CENTAVOS</speak>`,
+		},
+		"Palabra de tres letras": {
+			number:   1,
+			currency: "SOL",
+			cents:    "CÉNTIMOS",
+			expected: `<speak>UNO SOL</speak>`,
+		},
+		"Escapa XML": {
+			number:   1,
+			currency: "R&D",
+			cents:    "",
+			expected: `<speak>UNO R&amp;D</speak>`,
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			money, err := s.Money(tt.number, 2, tt.currency, tt.cents)
+			if err != nil {
+				t.Fatalf("Money(%v) returned error: %v", tt.number, err)
+			}
+			if money != tt.expected {
+				t.Errorf("Money(%v) = %v; want %v", tt.number, money, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSSMLInvoice(t *testing.T) {
+	s := NewSSML(NewNumeroALetras())
+	s.Pausa = 0
+	invoice, err := s.Invoice(1700.50, 2, "PEN")
+	if err != nil {
+		t.Fatalf("Invoice returned error: %v", err)
+	}
+	expected := `<speak>MIL SETECIENTOS CON <sub alias="CINCUENTA SOBRE CIEN">50/100</sub> <sub alias="SOLES">PEN</sub></speak>`
+	if invoice != expected {
+		t.Errorf("Invoice(1700.50) = %v; want %v", invoice, expected)
+	}
+
+	invoice, _ = s.Invoice(17, 2, "soles")
+	expected = `<speak>DIECISIETE CON <sub alias="CERO SOBRE CIEN">00/100</sub> SOLES</speak>`
+	if invoice != expected {
+		t.Errorf("Invoice(17) = %v; want %v", invoice, expected)
+	}
+}
@@ -0,0 +1,114 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type EstiloHora int
+
+const (
+	// HoraFormal, para actas: LAS QUINCE HORAS CON TREINTA MINUTOS.
+	HoraFormal EstiloHora = iota
+	// HoraColoquial: LAS TRES Y CUARTO DE LA TARDE.
+	HoraColoquial
+)
+
+func (n *NumeroALetras) ToTime(t time.Time, style EstiloHora) string {
+	h, m := t.Hour(), t.Minute()
+	if style == HoraColoquial {
+		return n.horaColoquial(h, m)
+	}
+
+	var res string
+	switch h {
+	case 0:
+		res = "LAS CERO HORAS"
+	case 1:
+		res = "LA UNA HORA"
+	default:
+		res = fmt.Sprintf("LAS %s HORAS", n.cardinal(h, Femenino))
+	}
+	if m > 0 {
+		res += fmt.Sprintf(" %s %s", strings.ToUpper(n.Conector), conSustantivo(n.cardinal(m, Masculino), m, "MINUTO", "MINUTOS"))
+	}
+	return res
+}
+
+func (n *NumeroALetras) horaColoquial(h, m int) string {
+	var minutos string
+	switch m {
+	case 0:
+	case 15:
+		minutos = " Y CUARTO"
+	case 30:
+		minutos = " Y MEDIA"
+	case 45:
+		minutos = " MENOS CUARTO"
+		h = (h + 1) % 24
+	default:
+		minutos = " Y " + n.sinApocope().convertNumber(m)
+	}
+
+	var periodo string
+	switch {
+	case h == 0 || h >= 20:
+		periodo = "DE LA NOCHE"
+	case h < 12:
+		periodo = "DE LA MAÑANA"
+	case h == 12:
+		periodo = "DEL MEDIODÍA"
+	default:
+		periodo = "DE LA TARDE"
+	}
+
+	h12 := h % 12
+	if h12 == 0 {
+		h12 = 12
+	}
+	if h12 == 1 {
+		return fmt.Sprintf("LA UNA%s %s", minutos, periodo)
+	}
+	return fmt.Sprintf("LAS %s%s %s", n.cardinal(h12, Femenino), minutos, periodo)
+}
+
+// ToDuration deletrea horas, minutos y segundos, omitiendo los que valen
+// cero: DOS HORAS, CINCO MINUTOS Y DIEZ SEGUNDOS.
+func (n *NumeroALetras) ToDuration(d time.Duration) string {
+	var prefix string
+	// Se niega tras pasar a segundos: -d desborda con math.MinInt64.
+	total := int(d / time.Second)
+	if total < 0 {
+		prefix = "MENOS "
+		total = -total
+	}
+	h, m, s := total/3600, total/60%60, total%60
+
+	var parts []string
+	if h > 0 {
+		parts = append(parts, conSustantivo(n.cardinal(h, Femenino), h, "HORA", "HORAS"))
+	}
+	if m > 0 {
+		parts = append(parts, conSustantivo(n.cardinal(m, Masculino), m, "MINUTO", "MINUTOS"))
+	}
+	if s > 0 {
+		parts = append(parts, conSustantivo(n.cardinal(s, Masculino), s, "SEGUNDO", "SEGUNDOS"))
+	}
+
+	switch len(parts) {
+	case 0:
+		return "CERO SEGUNDOS"
+	case 1:
+		return prefix + parts[0]
+	default:
+		return prefix + strings.Join(parts[:len(parts)-1], ", ") + " Y " + parts[len(parts)-1]
+	}
+}
+
+func conSustantivo(words string, count int, singular, plural string) string {
+	if count == 1 {
+		return words + " " + singular
+	}
+	return words + " " + plural
+}
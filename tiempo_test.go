@@ -0,0 +1,65 @@
+package numeroaletras
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestToTime(t *testing.T) {
+	tests := map[string]struct {
+		hour     int
+		minute   int
+		style    EstiloHora
+		expected string
+	}{
+		"Formal":               {hour: 15, minute: 30, style: HoraFormal, expected: "LAS QUINCE HORAS CON TREINTA MINUTOS"},
+		"Formal en punto":      {hour: 9, minute: 0, style: HoraFormal, expected: "LAS NUEVE HORAS"},
+		"Formal una":           {hour: 1, minute: 1, style: HoraFormal, expected: "LA UNA HORA CON UN MINUTO"},
+		"Formal veintiuna":     {hour: 21, minute: 21, style: HoraFormal, expected: "LAS VEINTIUNA HORAS CON VEINTIÚN MINUTOS"},
+		"Formal medianoche":    {hour: 0, minute: 5, style: HoraFormal, expected: "LAS CERO HORAS CON CINCO MINUTOS"},
+		"Coloquial cuarto":     {hour: 15, minute: 15, style: HoraColoquial, expected: "LAS TRES Y CUARTO DE LA TARDE"},
+		"Coloquial media":      {hour: 7, minute: 30, style: HoraColoquial, expected: "LAS SIETE Y MEDIA DE LA MAÑANA"},
+		"Coloquial menos":      {hour: 12, minute: 45, style: HoraColoquial, expected: "LA UNA MENOS CUARTO DE LA TARDE"},
+		"Coloquial noche":      {hour: 22, minute: 10, style: HoraColoquial, expected: "LAS DIEZ Y DIEZ DE LA NOCHE"},
+		"Coloquial mediodía":   {hour: 12, minute: 0, style: HoraColoquial, expected: "LAS DOCE DEL MEDIODÍA"},
+		"Coloquial medianoche": {hour: 0, minute: 21, style: HoraColoquial, expected: "LAS DOCE Y VEINTIUNO DE LA NOCHE"},
+	}
+
+	n := NewNumeroALetras()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			date := time.Date(2026, time.October, 18, tt.hour, tt.minute, 0, 0, time.UTC)
+			if got := n.ToTime(date, tt.style); got != tt.expected {
+				t.Errorf("ToTime(%02d:%02d) = %v; se esperaba %v", tt.hour, tt.minute, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToDuration(t *testing.T) {
+	tests := map[string]struct {
+		duration time.Duration
+		expected string
+	}{
+		"Completa":      {duration: 2*time.Hour + 5*time.Minute + 10*time.Second, expected: "DOS HORAS, CINCO MINUTOS Y DIEZ SEGUNDOS"},
+		"Singulares":    {duration: time.Hour + time.Minute + time.Second, expected: "UNA HORA, UN MINUTO Y UN SEGUNDO"},
+		"Dos partes":    {duration: 90 * time.Minute, expected: "UNA HORA Y TREINTA MINUTOS"},
+		"Solo segundos": {duration: 21 * time.Second, expected: "VEINTIÚN SEGUNDOS"},
+		"Más de un día": {duration: 31 * time.Hour, expected: "TREINTA Y UNA HORAS"},
+		"Cero":          {duration: 500 * time.Millisecond, expected: "CERO SEGUNDOS"},
+		"Negativa":      {duration: -3 * time.Minute, expected: "MENOS TRES MINUTOS"},
+		"Mínima":        {duration: math.MinInt64, expected: "MENOS DOS MILLONES QUINIENTAS SESENTA Y DOS MIL CUARENTA Y SIETE HORAS, CUARENTA Y SIETE MINUTOS Y DIECISÉIS SEGUNDOS"},
+	}
+
+	n := NewNumeroALetras()
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if got := n.ToDuration(tt.duration); got != tt.expected {
+				t.Errorf("ToDuration(%v) = %v; se esperaba %v", tt.duration, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package numeroaletras
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TokenKind int
+
+const (
+	TokenNumeral TokenKind = iota
+	TokenScale
+	TokenConnector
+	TokenCurrency
+	TokenMinorUnit
+	TokenFraction
+	TokenSign
+)
+
+var tokenKinds = []string{"numeral", "scale", "connector", "currency", "minor", "fraction", "sign"}
+
+func (k TokenKind) String() string {
+	if k < 0 || int(k) >= len(tokenKinds) {
+		return fmt.Sprintf("TokenKind(%d)", int(k))
+	}
+	return tokenKinds[k]
+}
+
+// Token es una pieza del resultado con su función. Digits guarda las cifras
+// de origen: el grupo que deletrea un numeral (21 en VEINTIÚN MIL), "1" en un
+// MIL sin multiplicador y "-" en el signo; MIL y MILLONES tras un numeral no
+// llevan cifras propias. El texto plano y el SSML se generan a partir de la
+// misma secuencia.
+type Token struct {
+	Kind   TokenKind
+	Text   string
+	Digits string
+}
+
+func (n *NumeroALetras) WordsTokens(number float64, decimals int) ([]Token, error) {
+	number = n.redondear(number, decimals)
+	whole, fraction := splitNumber(number, decimals)
+	return n.wordsTokens(whole, fraction)
+}
+
+func (n *NumeroALetras) MoneyTokens(number float64, decimals int, currency, cents string) ([]Token, error) {
+	whole, fraction := splitNumber(number, decimals)
+	return n.moneyTokens(whole, fraction, currency, cents)
+}
+
+func (n *NumeroALetras) InvoiceTokens(number float64, decimals int, currency string) ([]Token, error) {
+	whole, fraction := splitNumber(number, decimals)
+	return n.invoiceTokens(whole, fraction, currency)
+}
+
+func (n *NumeroALetras) wordsTokens(wholeDigits, fraction string) ([]Token, error) {
+	var s salida
+	if err := n.escribirWords(&s, []byte(wholeDigits), []byte(fraction)); err != nil {
+		return nil, err
+	}
+	return s.tokens, nil
+}
+
+func (n *NumeroALetras) moneyTokens(wholeDigits, fraction, currency, cents string) ([]Token, error) {
+	var s salida
+	if err := n.escribirMoney(&s, []byte(wholeDigits), []byte(fraction), currency, cents); err != nil {
+		return nil, err
+	}
+	return s.tokens, nil
+}
+
+func (n *NumeroALetras) invoiceTokens(wholeDigits, fraction, currency string) ([]Token, error) {
+	var s salida
+	if err := n.escribirInvoice(&s, []byte(wholeDigits), []byte(fraction), currency); err != nil {
+		return nil, err
+	}
+	return s.tokens, nil
+}
+
+func (n *NumeroALetras) escribirWords(s *salida, whole, fraction []byte) error {
+	if err := n.escribirEntero(s, whole, fraction); err != nil {
+		return err
+	}
+	_, err := n.escribirDecimales(s, fraction)
+	return err
+}
+
+func (n *NumeroALetras) escribirMoney(s *salida, whole, fraction []byte, currency, cents string) error {
+	if err := n.escribirEntero(s, whole, fraction); err != nil {
+		return err
+	}
+	s.add(TokenCurrency, strings.ToUpper(currency), nil)
+	ok, err := n.escribirDecimales(s, fraction)
+	if ok {
+		s.add(TokenMinorUnit, strings.ToUpper(cents), nil)
+	}
+	return err
+}
+
+func (n *NumeroALetras) escribirInvoice(s *salida, whole, fraction []byte, currency string) error {
+	if err := n.escribirEntero(s, whole, fraction); err != nil {
+		return err
+	}
+	if !isDigits(fraction) {
+		return fmt.Errorf("numeroaletras: decimales inválidos %s", string(fraction))
+	}
+	s.add(TokenConnector, strings.ToUpper(n.Conector), nil)
+	s.fraccion(fraction)
+	s.add(TokenCurrency, strings.ToUpper(currency), nil)
+	return nil
+}
+
+// Render une los textos de los tokens con un espacio, igual que ToWords,
+// ToMoney y ToInvoice.
+func Render(tokens []Token) string {
+	var parts []string
+	for _, t := range tokens {
+		if text := strings.Join(strings.Fields(t.Text), " "); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
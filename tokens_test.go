@@ -0,0 +1,123 @@
+package numeroaletras
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWordsTokens(t *testing.T) {
+	n := NewNumeroALetras()
+	n.UseApocope(true)
+
+	tests := map[string]struct {
+		number   float64
+		decimals int
+		expected []Token
+	}{
+		"Mil sin multiplicador": {
+			number: 1021,
+			expected: []Token{
+				{Kind: TokenScale, Text: "MIL", Digits: "1"},
+				{Kind: TokenNumeral, Text: "VEINTIÚN", Digits: "21"},
+			},
+		},
+		"Millones y miles": {
+			number: 21500000,
+			expected: []Token{
+				{Kind: TokenNumeral, Text: "VEINTIÚN", Digits: "21"},
+				{Kind: TokenScale, Text: "MILLONES"},
+				{Kind: TokenNumeral, Text: "QUINIENTOS", Digits: "500"},
+				{Kind: TokenScale, Text: "MIL"},
+			},
+		},
+		"Un millón": {
+			number: 1000000,
+			expected: []Token{
+				{Kind: TokenNumeral, Text: "UN", Digits: "1"},
+				{Kind: TokenScale, Text: "MILLÓN"},
+			},
+		},
+		"Negativo con decimales": {
+			number:   -5.25,
+			decimals: 2,
+			expected: []Token{
+				{Kind: TokenSign, Text: "MENOS", Digits: "-"},
+				{Kind: TokenNumeral, Text: "CINCO", Digits: "5"},
+				{Kind: TokenConnector, Text: "CON"},
+				{Kind: TokenNumeral, Text: "VEINTICINCO", Digits: "25"},
+			},
+		},
+		"Cero": {
+			number:   -0.001,
+			decimals: 2,
+			expected: []Token{
+				{Kind: TokenNumeral, Text: "CERO", Digits: "0"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			tokens, err := n.WordsTokens(tt.number, tt.decimals)
+			if err != nil {
+				t.Fatalf("WordsTokens returned error: %v", err)
+			}
+			if !reflect.DeepEqual(tokens, tt.expected) {
+				t.Errorf("WordsTokens(%v) = %+v; want %+v", tt.number, tokens, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMoneyTokens(t *testing.T) {
+	n := NewNumeroALetras()
+	tokens, err := n.MoneyTokens(2001.5, 2, "soles", "céntimos")
+	if err != nil {
+		t.Fatalf("MoneyTokens returned error: %v", err)
+	}
+	expected := []Token{
+		{Kind: TokenNumeral, Text: "DOS", Digits: "2"},
+		{Kind: TokenScale, Text: "MIL"},
+		{Kind: TokenNumeral, Text: "UNO", Digits: "1"},
+		{Kind: TokenCurrency, Text: "SOLES"},
+		{Kind: TokenConnector, Text: "CON"},
+		{Kind: TokenNumeral, Text: "CINCUENTA", Digits: "50"},
+		{Kind: TokenMinorUnit, Text: "CÉNTIMOS"},
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("MoneyTokens = %+v; want %+v", tokens, expected)
+	}
+	if got, want := Render(tokens), "DOS MIL UNO SOLES CON CINCUENTA CÉNTIMOS"; got != want {
+		t.Errorf("Render = %v; want %v", got, want)
+	}
+}
+
+func TestInvoiceTokens(t *testing.T) {
+	n := NewNumeroALetras()
+	n.UseEscala(EscalaCorta)
+	tokens, err := n.InvoiceTokens(-2000000000.07, 2, "USD")
+	if err != nil {
+		t.Fatalf("InvoiceTokens returned error: %v", err)
+	}
+	expected := []Token{
+		{Kind: TokenSign, Text: "MENOS", Digits: "-"},
+		{Kind: TokenNumeral, Text: "DOS", Digits: "2"},
+		{Kind: TokenScale, Text: "BILLONES"},
+		{Kind: TokenConnector, Text: "CON"},
+		{Kind: TokenFraction, Text: "07/100", Digits: "07"},
+		{Kind: TokenCurrency, Text: "USD"},
+	}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("InvoiceTokens = %+v; want %+v", tokens, expected)
+	}
+}
+
+func TestTokenKind_String(t *testing.T) {
+	if got := TokenScale.String(); got != "scale" {
+		t.Errorf("TokenScale.String() = %v; want scale", got)
+	}
+	if got := TokenKind(42).String(); got != "TokenKind(42)" {
+		t.Errorf("TokenKind(42).String() = %v; want TokenKind(42)", got)
+	}
+}
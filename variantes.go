@@ -0,0 +1,28 @@
+package numeroaletras
+
+type Variante int
+
+const (
+	// VarianteRAE sigue la Ortografía de 2010: DIECISÉIS, VEINTIÚN y
+	// MIL MILLONES para 10^9.
+	VarianteRAE Variante = iota
+	// VarianteAntigua reproduce las grafías de protocolos notariales
+	// antiguos: DIEZ Y SEIS, VEINTE Y UNO.
+	VarianteAntigua
+	// VarianteMillardo usa las grafías modernas pero nombra 10^9 como
+	// MILLARDO, como algunos bancos y países de la región.
+	VarianteMillardo
+)
+
+// UseVariante reemplaza las tablas de unidades, decenas y acentos por las de
+// la variante indicada, y con ellas las grafías precalculadas de 0 a 999.
+func (n *NumeroALetras) UseVariante(v Variante) {
+	n.variante = v
+	switch v {
+	case VarianteAntigua:
+		n.tablas = &espanolAntiguo
+	default:
+		n.tablas = &espanolRAE
+	}
+	n.grupos = n.tablaGrupos()
+}
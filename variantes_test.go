@@ -0,0 +1,69 @@
+package numeroaletras
+
+import (
+	"testing"
+)
+
+func TestVariantes(t *testing.T) {
+	tests := map[string]struct {
+		variante Variante
+		apocope  bool
+		number   float64
+		expected string
+	}{
+		// VarianteRAE
+		"RAE dieciséis":         {variante: VarianteRAE, number: 16, expected: "DIECISÉIS"},
+		"RAE veintiuno":         {variante: VarianteRAE, number: 21, expected: "VEINTIUNO"},
+		"RAE veintiún":          {variante: VarianteRAE, apocope: true, number: 21, expected: "VEINTIÚN"},
+		"RAE veintiún mil":      {variante: VarianteRAE, number: 21000, expected: "VEINTIÚN MIL"},
+		"RAE ciento un mil":     {variante: VarianteRAE, number: 101001, expected: "CIENTO UN MIL UNO"},
+		"RAE mil millones":      {variante: VarianteRAE, number: 1e9, expected: "MIL MILLONES"},
+		"RAE mil quinientos":    {variante: VarianteRAE, number: 1.5e9, expected: "MIL QUINIENTOS MILLONES"},
+		"RAE veintiún millones": {variante: VarianteRAE, number: 21e6, expected: "VEINTIÚN MILLONES"},
+		"RAE un billón":         {variante: VarianteRAE, number: 1e12, expected: "UN BILLÓN"},
+		"RAE billones":          {variante: VarianteRAE, number: 2000003000004, expected: "DOS BILLONES TRES MILLONES CUATRO"},
+
+		// VarianteAntigua
+		"Antigua diez y seis":     {variante: VarianteAntigua, number: 16, expected: "DIEZ Y SEIS"},
+		"Antigua diez y nueve":    {variante: VarianteAntigua, number: 1019, expected: "MIL DIEZ Y NUEVE"},
+		"Antigua veinte":          {variante: VarianteAntigua, number: 20, expected: "VEINTE"},
+		"Antigua veinte y uno":    {variante: VarianteAntigua, number: 21, expected: "VEINTE Y UNO"},
+		"Antigua veinte y dos":    {variante: VarianteAntigua, number: 22, expected: "VEINTE Y DOS"},
+		"Antigua veinte y un":     {variante: VarianteAntigua, apocope: true, number: 121, expected: "CIENTO VEINTE Y UN"},
+		"Antigua veinte y un mil": {variante: VarianteAntigua, number: 21000, expected: "VEINTE Y UN MIL"},
+		"Antigua treinta y uno":   {variante: VarianteAntigua, number: 31, expected: "TREINTA Y UNO"},
+
+		// VarianteMillardo
+		"Millardo veintidós":    {variante: VarianteMillardo, number: 22, expected: "VEINTIDÓS"},
+		"Millardo uno":          {variante: VarianteMillardo, number: 1e9, expected: "UN MILLARDO"},
+		"Millardo y millones":   {variante: VarianteMillardo, number: 1.5e9, expected: "UN MILLARDO QUINIENTOS MILLONES"},
+		"Millardos":             {variante: VarianteMillardo, number: 21001000000, expected: "VEINTIÚN MILLARDOS UN MILLÓN"},
+		"Millardo sin millones": {variante: VarianteMillardo, number: 3e6, expected: "TRES MILLONES"},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			n := NewNumeroALetras()
+			n.UseVariante(tt.variante)
+			n.UseApocope(tt.apocope)
+			words, err := n.ToWords(tt.number, 0)
+			if err != nil {
+				t.Fatalf("ToWords(%v) retornó error: %v", tt.number, err)
+			}
+			if words != tt.expected {
+				t.Errorf("ToWords(%v) = %v; se esperaba %v", tt.number, words, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToWords_FueraDeRango(t *testing.T) {
+	n := NewNumeroALetras()
+	if _, err := n.ToWords(1e25, 0); err == nil {
+		t.Error("ToWords(1e25) expected error, got nil")
+	}
+	if _, err := n.ToWords(1e25, 2); err == nil {
+		t.Error("ToWords(1e25, 2) expected error, got nil")
+	}
+}